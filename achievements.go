@@ -0,0 +1,160 @@
+package hypixel
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// OneTimeAchievement definition from GetAchievements
+type OneTimeAchievement struct {
+	Name                  string  `json:"name"`
+	Description           string  `json:"description"`
+	Points                int     `json:"points"`
+	Legacy                bool    `json:"legacy"`
+	GamePercentUnlocked   float64 `json:"gamePercentUnlocked"`
+	GlobalPercentUnlocked float64 `json:"globalPercentUnlocked"`
+}
+
+// AchievementTier one tier of a TieredAchievement
+type AchievementTier struct {
+	Tier   int   `json:"tier"`
+	Points int   `json:"points"`
+	Amount int64 `json:"amount"`
+}
+
+// TieredAchievement definition from GetAchievements
+type TieredAchievement struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"` // "%s" is the tier amount
+	Legacy      bool              `json:"legacy"`
+	Tiers       []AchievementTier `json:"tiers"`
+}
+
+// GameAchievements achievements of one game
+type GameAchievements struct {
+	OneTime           map[string]OneTimeAchievement `json:"one_time"`
+	Tiered            map[string]TieredAchievement  `json:"tiered"`
+	TotalPoints       int                           `json:"total_points"`
+	TotalLegacyPoints int                           `json:"total_legacy_points"`
+}
+
+// Achievements GetAchievements keyed by game ("bedwars", "skywars"...)
+type Achievements map[string]GameAchievements
+
+// ParseAchievements decode a GetAchievements response
+func ParseAchievements(resp Response) (Achievements, error) {
+	var body struct {
+		Achievements Achievements `json:"achievements"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	return body.Achievements, nil
+}
+
+// GameAchievementProgress a player's achievements in one game
+type GameAchievementProgress struct {
+	Points         int
+	PossiblePoints int // legacy achievements excluded
+	LegacyPoints   int
+	Unlocked       int // one time achievements and tiers unlocked
+	Total          int // one time achievements and tiers available
+}
+
+// Completion percentage of PossiblePoints earned
+func (g GameAchievementProgress) Completion() float64 {
+	if g.PossiblePoints == 0 {
+		return 0
+	}
+	return float64(g.Points) / float64(g.PossiblePoints) * 100
+}
+
+// RemainingAchievement an achievement or tier not unlocked yet
+type RemainingAchievement struct {
+	Game     string
+	Key      string // player key, e.g. "bedwars_wins"
+	Name     string
+	Points   int
+	Tiered   bool
+	Tier     int   // next tier, 0 for one time achievements
+	Progress int64 // tiered only
+	Required int64 // tiered only
+}
+
+// AchievementProgress a player's achievements joined with the definitions
+type AchievementProgress struct {
+	GameAchievementProgress                                    // totals over every game
+	Games                   map[string]GameAchievementProgress // keyed by game
+	Remaining               []RemainingAchievement             // sorted by game then key, legacy excluded
+}
+
+// Progress join the player's achievementsOneTime and achievements with the definitions
+func (a Achievements) Progress(p *Player) AchievementProgress {
+	oneTime := make(map[string]bool, len(p.AchievementsOneTime))
+	for _, k := range p.AchievementsOneTime {
+		oneTime[strings.ToLower(k)] = true
+	}
+	progress := AchievementProgress{Games: map[string]GameAchievementProgress{}}
+
+	for game, defs := range a {
+		var g GameAchievementProgress
+		for name, def := range defs.OneTime {
+			key := strings.ToLower(game + "_" + name)
+			unlocked := oneTime[key]
+			switch {
+			case def.Legacy && unlocked:
+				g.LegacyPoints += def.Points
+			case def.Legacy:
+			case unlocked:
+				g.Points += def.Points
+				g.PossiblePoints += def.Points
+				g.Unlocked++
+				g.Total++
+			default:
+				g.PossiblePoints += def.Points
+				g.Total++
+				progress.Remaining = append(progress.Remaining, RemainingAchievement{Game: game, Key: key, Name: def.Name, Points: def.Points})
+			}
+		}
+		for name, def := range defs.Tiered {
+			key := strings.ToLower(game + "_" + name)
+			amount := p.Achievements[key]
+			next := true
+			for _, tier := range def.Tiers {
+				unlocked := amount >= tier.Amount
+				switch {
+				case def.Legacy && unlocked:
+					g.LegacyPoints += tier.Points
+				case def.Legacy:
+				case unlocked:
+					g.Points += tier.Points
+					g.PossiblePoints += tier.Points
+					g.Unlocked++
+					g.Total++
+				default:
+					g.PossiblePoints += tier.Points
+					g.Total++
+					if next {
+						next = false
+						progress.Remaining = append(progress.Remaining, RemainingAchievement{
+							Game: game, Key: key, Name: def.Name, Points: tier.Points,
+							Tiered: true, Tier: tier.Tier, Progress: amount, Required: tier.Amount,
+						})
+					}
+				}
+			}
+		}
+		progress.Games[game] = g
+		progress.Points += g.Points
+		progress.PossiblePoints += g.PossiblePoints
+		progress.LegacyPoints += g.LegacyPoints
+		progress.Unlocked += g.Unlocked
+		progress.Total += g.Total
+	}
+
+	slices.SortFunc(progress.Remaining, func(x, y RemainingAchievement) int {
+		return cmp.Or(cmp.Compare(x.Game, y.Game), cmp.Compare(x.Key, y.Key))
+	})
+	return progress
+}
@@ -0,0 +1,45 @@
+package hypixel
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAchievements_Progress(t *testing.T) {
+	defs, err := ParseAchievements(Response{Content: []byte(`{"success":true,"achievements":{
+		"bedwars":{
+			"one_time":{"FIRST_WIN":{"name":"First Win","points":5},"OLD":{"name":"Old","points":10,"legacy":true}},
+			"tiered":{"WINS":{"name":"Winner","tiers":[{"tier":1,"points":5,"amount":10},{"tier":2,"points":10,"amount":100},{"tier":3,"points":15,"amount":500}]}}
+		},
+		"duels":{"one_time":{"SUMO":{"name":"Sumo","points":20}}}
+	}}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := &Player{
+		AchievementsOneTime: StringList{"bedwars_first_win", "bedwars_old"},
+		Achievements:        map[string]int64{"bedwars_wins": 150},
+	}
+	got := defs.Progress(p)
+
+	if got.Points != 20 || got.PossiblePoints != 55 || got.LegacyPoints != 10 {
+		t.Errorf("totals = %+v", got.GameAchievementProgress)
+	}
+	bw := got.Games["bedwars"]
+	if bw.Points != 20 || bw.PossiblePoints != 35 || bw.Unlocked != 3 || bw.Total != 4 {
+		t.Errorf("bedwars = %+v", bw)
+	}
+	if c := bw.Completion(); math.Abs(c-57.142857) > 1e-4 {
+		t.Errorf("completion = %v", c)
+	}
+	if len(got.Remaining) != 2 {
+		t.Fatalf("remaining = %+v", got.Remaining)
+	}
+	wins := got.Remaining[0]
+	if wins.Key != "bedwars_wins" || wins.Tier != 3 || wins.Progress != 150 || wins.Required != 500 {
+		t.Errorf("next tier = %+v", wins)
+	}
+	if got.Remaining[1].Key != "duels_sumo" || got.Remaining[1].Tiered {
+		t.Errorf("one time remaining = %+v", got.Remaining[1])
+	}
+}
@@ -2,11 +2,14 @@ package hypixel
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
+	"time"
 )
 
 type Request struct {
+	Context context.Context // nil means context.Background
 	Method  string
 	Header  http.Header
 	Path    string
@@ -21,10 +24,97 @@ type Response struct {
 	URL     string // Replace full url in Callback
 	Status  int
 	Content []byte
+	Meta    ResponseMeta // Filled by Get, overwrites what hooks return
+}
+
+// ResponseSource where a Response came from
+type ResponseSource uint8
+
+const (
+	SourceNetwork  ResponseSource = iota // fetched from the Hypixel API
+	SourceHook                           // returned by PreRequestHook, usually a cache hit
+	SourceCallback                       // replaced by Callback
+)
+
+// String impl fmt.Stringer
+func (s ResponseSource) String() string {
+	switch s {
+	case SourceNetwork:
+		return "network"
+	case SourceHook:
+		return "hook"
+	case SourceCallback:
+		return "callback"
+	}
+	return "unknown"
+}
+
+// ResponseMeta how a Get call went
+type ResponseMeta struct {
+	Duration      time.Duration // whole Get call
+	RateWait      time.Duration // blocked in RateLimit.WaitIfNeeded
+	Attempts      int           // HTTP round trips, 0 when served by PreRequestHook
+	Source        ResponseSource
+	RateRemaining int32     // RateLimit remaining after the response, -1 == unknown
+	RateResetAt   time.Time // RateLimit reset after the response, zero == unknown
+	BodySize      int64     // bytes read from the network body
+}
+
+// Retries attempts after the first one
+func (m ResponseMeta) Retries() int {
+	if m.Attempts > 1 {
+		return m.Attempts - 1
+	}
+	return 0
+}
+
+// UntilReset time left until RateResetAt, 0 if unknown or already passed
+func (m ResponseMeta) UntilReset() time.Duration {
+	if m.RateResetAt.IsZero() {
+		return 0
+	}
+	return max(time.Until(m.RateResetAt), 0)
+}
+
+// size response size for logs and metrics
+func (r Response) size() int {
+	if r.Content == nil {
+		return int(r.Meta.BodySize)
+	}
+	return len(r.Content)
+}
+
+// cacheStatus "hit" when served by PreRequestHook
+func (m ResponseMeta) cacheStatus() string {
+	if m.Source == SourceHook {
+		return "hit"
+	}
+	return "miss"
 }
 
 // Get Hypixel API HTTP Request
 func (c *Client) Get(r Request) (Response, error) {
+	return c.do(r, nil)
+}
+
+// do send r, when stream is set the body goes to it instead of Content
+// and PreRequestHook/Callback are skipped
+func (c *Client) do(r Request, stream func(resp Response, body io.Reader) error) (response Response, err error) {
+	start := time.Now()
+	meta := ResponseMeta{RateRemaining: -1}
+	ctx := r.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, span := c.startSpan(ctx, r)
+	defer func() {
+		meta.Duration = time.Since(start)
+		response.Meta = meta
+		c.logRequest(r, response, err)
+		c.observeRequest(r, response, err)
+		c.endSpan(span, r, response, err)
+	}()
+
 	if r.Method == "" {
 		r.Method = http.MethodGet
 	}
@@ -34,13 +124,14 @@ func (c *Client) Get(r Request) (Response, error) {
 	}
 	r.URL = r.Params.String(r.URL)
 
-	if c.GetPreRequestHook() != nil {
-		response, err := c.GetPreRequestHook()(r)
-		if err == nil {
-			return response, nil
+	if stream == nil && c.GetPreRequestHook() != nil {
+		hooked, hookErr := c.GetPreRequestHook()(r)
+		if hookErr == nil {
+			meta.Source = SourceHook
+			return hooked, nil
 		}
 	}
-	req, err := http.NewRequest(r.Method, r.URL,
+	req, err := http.NewRequestWithContext(ctx, r.Method, r.URL,
 		func() io.Reader {
 			if r.Payload != nil {
 				return bytes.NewReader(r.Payload)
@@ -54,9 +145,8 @@ func (c *Client) Get(r Request) (Response, error) {
 	if r.Header != nil {
 		req.Header = r.Header
 	}
-	if c.GetRate() != nil {
-		c.GetRate().WaitIfNeeded()
-	}
+	meta.RateWait = c.waitRate(span)
+	meta.Attempts++
 	rsp, err := c.GetHTTPClient().Do(req)
 	if err != nil {
 		return Response{}, err
@@ -64,16 +154,28 @@ func (c *Client) Get(r Request) (Response, error) {
 	defer rsp.Body.Close()
 	if c.GetRate() != nil {
 		_ = c.GetRate().UpdateFromResponse(rsp)
+		meta.RateRemaining = c.GetRate().GetRemaining()
+		meta.RateResetAt = c.GetRate().GetResetAt()
+	}
+	body := &countReader{r: limitBody(rsp.Body, c.GetMaxBodySize())}
+	if stream != nil {
+		resp := Response{Header: rsp.Header, Path: r.Path, URL: r.URL, Status: rsp.StatusCode}
+		err = stream(resp, body)
+		meta.BodySize = body.n
+		return resp, err
 	}
-	content, err := io.ReadAll(rsp.Body)
+	content, err := io.ReadAll(body)
+	meta.BodySize = body.n
 	if err != nil {
 		return Response{}, err
 	}
-	resp := Response{Header: rsp.Header, Path: r.Path, URL: r.URL, Status: rsp.StatusCode, Content: content}
+	meta.Duration = time.Since(start)
+	resp := Response{Header: rsp.Header, Path: r.Path, URL: r.URL, Status: rsp.StatusCode, Content: content, Meta: meta}
 	if c.GetCallback() != nil {
-		response, err := c.GetCallback()(r, resp, err)
-		if err == nil {
-			return response, nil
+		called, callErr := c.GetCallback()(r, resp, err)
+		if callErr == nil {
+			meta.Source = SourceCallback
+			return called, nil
 		}
 	}
 	return resp, nil
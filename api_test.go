@@ -2,7 +2,9 @@ package hypixel
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestClient_Authentication(t *testing.T) {
@@ -16,3 +18,42 @@ func TestClient_Authentication(t *testing.T) {
 		t.Errorf("expected 'value1', got %s", h.Get("head"))
 	}
 }
+
+func TestClient_ResponseMeta(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "55")
+		w.Header().Set("RateLimit-Reset", "20")
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", NewRateLimit())
+	c.SetBaseURL(srv.URL)
+
+	resp, err := c.Get(Request{Path: "counts"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := resp.Meta
+	if m.Source != SourceNetwork || m.Attempts != 1 || m.Duration <= 0 {
+		t.Errorf("network meta = %+v", m)
+	}
+	if m.RateRemaining != 55 || m.UntilReset() <= 0 || m.UntilReset() > 20*time.Second {
+		t.Errorf("rate snapshot remaining=%d until=%v", m.RateRemaining, m.UntilReset())
+	}
+
+	c.SetCallback(func(_ Request, response Response, _ error) (Response, error) {
+		return response, nil
+	})
+	if resp, _ = c.Get(Request{Path: "counts"}); resp.Meta.Source != SourceCallback {
+		t.Errorf("Source = %s; want callback", resp.Meta.Source)
+	}
+
+	c.SetPreRequestHook(func(Request) (Response, error) {
+		return Response{Status: 200}, nil
+	})
+	resp, _ = c.Get(Request{Path: "counts"})
+	if resp.Meta.Source != SourceHook || resp.Meta.Attempts != 0 || resp.Meta.RateRemaining != -1 {
+		t.Errorf("hook meta = %+v", resp.Meta)
+	}
+}
@@ -0,0 +1,222 @@
+package hypixel
+
+import (
+	"math"
+	"strconv"
+)
+
+// Bedwars mode ids, the stat key prefix used by the API
+const (
+	BedwarsSolo         = "eight_one"
+	BedwarsDoubles      = "eight_two"
+	BedwarsThrees       = "four_three" // 3v3v3v3
+	BedwarsFours        = "four_four"  // 4v4v4v4
+	BedwarsTwoFours     = "two_four"   // 4v4
+	BedwarsCastle       = "castle"     // 40v40
+	BedwarsRushDoubles  = "eight_two_rush"
+	BedwarsRushFours    = "four_four_rush"
+	BedwarsUltimateDuo  = "eight_two_ultimate"
+	BedwarsUltimateFour = "four_four_ultimate"
+	BedwarsLuckyDoubles = "eight_two_lucky"
+	BedwarsLuckyFours   = "four_four_lucky"
+	BedwarsArmedDoubles = "eight_two_armed"
+	BedwarsArmedFours   = "four_four_armed"
+	BedwarsVoidDoubles  = "eight_two_voidless"
+	BedwarsVoidFours    = "four_four_voidless"
+	BedwarsSwapDoubles  = "eight_two_swap"
+	BedwarsSwapFours    = "four_four_swap"
+)
+
+// BedwarsModes every mode read by Player.Bedwars, core modes first then dreams
+var BedwarsModes = []string{
+	BedwarsSolo, BedwarsDoubles, BedwarsThrees, BedwarsFours, BedwarsTwoFours,
+	BedwarsCastle,
+	BedwarsRushDoubles, BedwarsRushFours,
+	BedwarsUltimateDuo, BedwarsUltimateFour,
+	BedwarsLuckyDoubles, BedwarsLuckyFours,
+	BedwarsArmedDoubles, BedwarsArmedFours,
+	BedwarsVoidDoubles, BedwarsVoidFours,
+	BedwarsSwapDoubles, BedwarsSwapFours,
+}
+
+// BedwarsMode counters of one mode, or of all modes for Bedwars.Overall
+type BedwarsMode struct {
+	GamesPlayed int64
+	Wins        int64
+	Losses      int64
+	Kills       int64
+	Deaths      int64
+	FinalKills  int64
+	FinalDeaths int64
+	BedsBroken  int64
+	BedsLost    int64
+	Winstreak   int64 // -1 when hidden by the player's API settings
+}
+
+func (m BedwarsMode) FKDR() float64 { return Ratio(m.FinalKills, m.FinalDeaths) }
+func (m BedwarsMode) KDR() float64  { return Ratio(m.Kills, m.Deaths) }
+func (m BedwarsMode) WLR() float64  { return Ratio(m.Wins, m.Losses) }
+func (m BedwarsMode) BBLR() float64 { return Ratio(m.BedsBroken, m.BedsLost) }
+
+// Bedwars typed view of player.stats.Bedwars
+type Bedwars struct {
+	Overall    BedwarsMode
+	Modes      map[string]BedwarsMode // keyed by the Bedwars mode ids, played modes only
+	Experience float64
+	Coins      int64
+}
+
+// Bedwars build the Bedwars view from the player's stats
+func (p *Player) Bedwars() Bedwars {
+	s := p.GameStats("Bedwars")
+	b := Bedwars{
+		Overall:    bedwarsMode(s, ""),
+		Modes:      map[string]BedwarsMode{},
+		Experience: s.Float("Experience"),
+		Coins:      s.Int("coins"),
+	}
+	for _, mode := range BedwarsModes {
+		if m := bedwarsMode(s, mode+"_"); m.GamesPlayed > 0 || m.Wins > 0 || m.Losses > 0 {
+			b.Modes[mode] = m
+		}
+	}
+	return b
+}
+
+func bedwarsMode(s Stats, prefix string) BedwarsMode {
+	stat := func(name string) int64 {
+		return s.Int(prefix + name + "_bedwars")
+	}
+	m := BedwarsMode{
+		GamesPlayed: stat("games_played"),
+		Wins:        stat("wins"),
+		Losses:      stat("losses"),
+		Kills:       stat("kills"),
+		Deaths:      stat("deaths"),
+		FinalKills:  stat("final_kills"),
+		FinalDeaths: stat("final_deaths"),
+		BedsBroken:  stat("beds_broken"),
+		BedsLost:    stat("beds_lost"),
+		Winstreak:   -1,
+	}
+	if _, ok := s[prefix+"winstreak"]; ok {
+		m.Winstreak = s.Int(prefix + "winstreak")
+	}
+	return m
+}
+
+// a prestige is 100 stars, the first four cost less
+const bedwarsPrestigeExp = 487000
+
+var bedwarsEasyLevels = []float64{500, 1000, 2000, 3500}
+
+// Star exact star level and progress from Experience
+func (b Bedwars) Star() LevelProgress {
+	return BedwarsStar(b.Experience)
+}
+
+// Prestige prestige of the current star
+func (b Bedwars) Prestige() BedwarsPrestige {
+	return BedwarsPrestigeOf(b.Star().Level)
+}
+
+// BedwarsStar exact star level and progress from Bedwars experience
+func BedwarsStar(exp float64) LevelProgress {
+	if exp < 0 {
+		exp = 0
+	}
+	prestiges := math.Floor(exp / bedwarsPrestigeExp)
+	level := int(prestiges) * 100
+	rem := exp - prestiges*bedwarsPrestigeExp
+	span := 0.0
+	for _, cost := range bedwarsEasyLevels {
+		if rem < cost {
+			span = cost
+			break
+		}
+		level++
+		rem -= cost
+	}
+	if span == 0 {
+		span = 5000
+		n := math.Floor(rem / span)
+		level += int(n)
+		rem -= n * span
+	}
+	return LevelProgress{
+		Level:    level,
+		Exact:    float64(level) + rem/span,
+		Progress: rem / span,
+		XP:       rem,
+		XPToNext: span,
+	}
+}
+
+// BedwarsPrestige the prestige of a star level
+type BedwarsPrestige struct {
+	Name  string
+	Color string // main colour code
+}
+
+// bedwarsPrestiges indexed by star / 100, later stars keep the last entry
+var bedwarsPrestiges = []BedwarsPrestige{
+	{"Stone", "§7"},
+	{"Iron", "§f"},
+	{"Gold", "§6"},
+	{"Diamond", "§b"},
+	{"Emerald", "§2"},
+	{"Sapphire", "§3"},
+	{"Ruby", "§4"},
+	{"Crystal", "§d"},
+	{"Opal", "§9"},
+	{"Amethyst", "§5"},
+	{"Rainbow", "§6"},
+	{"Iron Prime", "§f"},
+	{"Gold Prime", "§e"},
+	{"Diamond Prime", "§b"},
+	{"Emerald Prime", "§a"},
+	{"Sapphire Prime", "§3"},
+	{"Ruby Prime", "§c"},
+	{"Crystal Prime", "§d"},
+	{"Opal Prime", "§9"},
+	{"Amethyst Prime", "§5"},
+	{"Mirror", "§7"},
+	{"Light", "§e"},
+	{"Dawn", "§6"},
+	{"Dusk", "§5"},
+	{"Air", "§b"},
+	{"Wrath", "§4"},
+	{"Nightmare", "§5"},
+	{"Lucid", "§a"},
+}
+
+// BedwarsPrestigeOf prestige name and colour of star
+func BedwarsPrestigeOf(star int) BedwarsPrestige {
+	i := min(max(star/100, 0), len(bedwarsPrestiges)-1)
+	return bedwarsPrestiges[i]
+}
+
+// rainbow colours used by the Rainbow prestige, one per character
+var rainbowColors = []string{"§c", "§6", "§e", "§a", "§b", "§d", "§5"}
+
+// FormatBedwarsStar colour coded star tag as shown in game, e.g. "§6[200✫]"
+func FormatBedwarsStar(star int) string {
+	icon := "✫"
+	switch {
+	case star >= 2100:
+		icon = "⚝"
+	case star >= 1100:
+		icon = "✪"
+	}
+	text := "[" + strconv.Itoa(star) + icon + "]"
+	if star/100 != 10 {
+		return BedwarsPrestigeOf(star).Color + text
+	}
+	out := ""
+	i := 0
+	for _, r := range text {
+		out += rainbowColors[i%len(rainbowColors)] + string(r)
+		i++
+	}
+	return out
+}
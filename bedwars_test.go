@@ -0,0 +1,74 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBedwarsStar(t *testing.T) {
+	tests := []struct {
+		exp  float64
+		star int
+	}{
+		{0, 0},
+		{499, 0},
+		{500, 1},
+		{1500, 2},
+		{3500, 3},
+		{7000, 4},
+		{12000, 5},
+		{486999, 99},
+		{487000, 100},
+		{487000*3 + 7000 + 5000*10, 314},
+	}
+	for _, tt := range tests {
+		if got := BedwarsStar(tt.exp).Level; got != tt.star {
+			t.Errorf("BedwarsStar(%v) = %d; want %d", tt.exp, got, tt.star)
+		}
+	}
+	if got := BedwarsStar(2500).Progress; got != 0.5 {
+		t.Errorf("progress = %v; want 0.5", got)
+	}
+}
+
+func TestBedwarsPrestige(t *testing.T) {
+	if got := BedwarsPrestigeOf(250).Name; got != "Gold" {
+		t.Errorf("prestige(250) = %s; want Gold", got)
+	}
+	if got := BedwarsPrestigeOf(99999).Name; got != bedwarsPrestiges[len(bedwarsPrestiges)-1].Name {
+		t.Errorf("prestige past table = %s", got)
+	}
+	if got := FormatBedwarsStar(314); got != "§b[314✫]" {
+		t.Errorf("FormatBedwarsStar(314) = %q", got)
+	}
+	if got := StripColor(FormatBedwarsStar(1000)); got != "[1000✫]" {
+		t.Errorf("rainbow star = %q", got)
+	}
+}
+
+func TestPlayer_Bedwars(t *testing.T) {
+	p := &Player{Stats: map[string]json.RawMessage{"Bedwars": json.RawMessage(`{
+		"Experience": 12000, "coins": 300,
+		"wins_bedwars": 10, "losses_bedwars": 5, "final_kills_bedwars": 30, "final_deaths_bedwars": 0,
+		"beds_broken_bedwars": 9, "beds_lost_bedwars": 3, "winstreak": 2,
+		"eight_one_wins_bedwars": 4, "eight_one_losses_bedwars": 1, "eight_one_final_kills_bedwars": 8, "eight_one_final_deaths_bedwars": 3,
+		"four_four_rush_games_played_bedwars": 2
+	}`)}}
+	b := p.Bedwars()
+	if b.Star().Level != 5 || b.Coins != 300 {
+		t.Errorf("star=%d coins=%d", b.Star().Level, b.Coins)
+	}
+	if b.Overall.WLR() != 2 || b.Overall.BBLR() != 3 || b.Overall.FKDR() != 30 || b.Overall.Winstreak != 2 {
+		t.Errorf("overall = %+v", b.Overall)
+	}
+	solo, ok := b.Modes[BedwarsSolo]
+	if !ok || solo.FKDR() != 2.67 || solo.Winstreak != -1 {
+		t.Errorf("solo = %+v", solo)
+	}
+	if _, ok := b.Modes[BedwarsRushFours]; !ok {
+		t.Error("dreams mode missing")
+	}
+	if _, ok := b.Modes[BedwarsDoubles]; ok {
+		t.Error("unplayed mode present")
+	}
+}
@@ -1,6 +1,7 @@
 package hypixel
 
 import (
+	"log/slog"
 	"net/http"
 	"strings"
 )
@@ -15,6 +16,11 @@ type Client struct {
 	rate           *RateLimit
 	preRequestHook PreRequestHook
 	callBack       Callback
+	logger         *slog.Logger
+	logLevels      LogLevels
+	metrics        Metrics
+	tracer         Tracer
+	maxBodySize    int64
 }
 
 // NewClient creates a new hypixel client
@@ -27,6 +33,7 @@ func NewClient(key string, rate *RateLimit) *Client {
 		apiKey:     key,
 		httpClient: http.DefaultClient,
 		rate:       rate,
+		logLevels:  DefaultLogLevels(),
 	}
 }
 
@@ -54,6 +61,30 @@ func (c *Client) GetCallback() Callback {
 	return c.callBack
 }
 
+// GetLogger nil means logging is disabled
+func (c *Client) GetLogger() *slog.Logger {
+	return c.logger
+}
+
+func (c *Client) GetLogLevels() LogLevels {
+	return c.logLevels
+}
+
+// GetMetrics nil means metrics are disabled
+func (c *Client) GetMetrics() Metrics {
+	return c.metrics
+}
+
+// GetTracer nil means tracing is disabled
+func (c *Client) GetTracer() Tracer {
+	return c.tracer
+}
+
+// GetMaxBodySize 0 means unlimited
+func (c *Client) GetMaxBodySize() int64 {
+	return c.maxBodySize
+}
+
 func (c *Client) GetFullPath(path string) string {
 	return strings.TrimRight(c.GetBaseURL(), "/") + "/" + strings.TrimLeft(path, "/")
 }
@@ -81,3 +112,27 @@ func (c *Client) SetPreRequestHook(beforeSend PreRequestHook) {
 func (c *Client) SetCallback(callBack Callback) {
 	c.callBack = callBack
 }
+
+// SetLogger enable request logging, nil to disable
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+func (c *Client) SetLogLevels(levels LogLevels) {
+	c.logLevels = levels
+}
+
+// SetMetrics enable request metrics, nil to disable
+func (c *Client) SetMetrics(metrics Metrics) {
+	c.metrics = metrics
+}
+
+// SetTracer enable a span per Get call, nil to disable
+func (c *Client) SetTracer(tracer Tracer) {
+	c.tracer = tracer
+}
+
+// SetMaxBodySize fail responses larger than size bytes with ErrBodyTooLarge, 0 to disable
+func (c *Client) SetMaxBodySize(size int64) {
+	c.maxBodySize = size
+}
@@ -0,0 +1,217 @@
+package hypixel
+
+import "strings"
+
+// Duels types, a type groups the modes sharing a title
+const (
+	DuelsUHC       = "UHC"
+	DuelsBridge    = "Bridge"
+	DuelsSumo      = "Sumo"
+	DuelsClassic   = "Classic"
+	DuelsOP        = "OP"
+	DuelsSkyWars   = "SkyWars"
+	DuelsCombo     = "Combo"
+	DuelsBow       = "Bow"
+	DuelsNoDebuff  = "NoDebuff"
+	DuelsBlitz     = "Blitz"
+	DuelsMegaWalls = "MegaWalls"
+	DuelsBoxing    = "Boxing"
+	DuelsBowSpleef = "BowSpleef"
+	DuelsParkour   = "Parkour"
+	DuelsArena     = "Arena"
+)
+
+// DuelsModeTypes mode id (the stat key prefix) to its Duels type
+var DuelsModeTypes = map[string]string{
+	"uhc_duel":       DuelsUHC,
+	"uhc_doubles":    DuelsUHC,
+	"uhc_four":       DuelsUHC,
+	"uhc_meetup":     DuelsUHC,
+	"bridge_duel":    DuelsBridge,
+	"bridge_doubles": DuelsBridge,
+	"bridge_threes":  DuelsBridge,
+	"bridge_four":    DuelsBridge,
+	"bridge_2v2v2v2": DuelsBridge,
+	"bridge_3v3v3v3": DuelsBridge,
+	"capture_threes": DuelsBridge,
+	"sumo_duel":      DuelsSumo,
+	"classic_duel":   DuelsClassic,
+	"op_duel":        DuelsOP,
+	"op_doubles":     DuelsOP,
+	"sw_duel":        DuelsSkyWars,
+	"sw_doubles":     DuelsSkyWars,
+	"combo_duel":     DuelsCombo,
+	"bow_duel":       DuelsBow,
+	"potion_duel":    DuelsNoDebuff,
+	"blitz_duel":     DuelsBlitz,
+	"mw_duel":        DuelsMegaWalls,
+	"mw_doubles":     DuelsMegaWalls,
+	"boxing_duel":    DuelsBoxing,
+	"bowspleef_duel": DuelsBowSpleef,
+	"parkour_eight":  DuelsParkour,
+	"duel_arena":     DuelsArena,
+}
+
+// DuelsMode counters of one mode, type or of all modes
+type DuelsMode struct {
+	GamesPlayed   int64 // rounds_played
+	Wins          int64
+	Losses        int64
+	Kills         int64
+	Deaths        int64
+	Winstreak     int64
+	BestWinstreak int64
+}
+
+func (m DuelsMode) KDR() float64 { return Ratio(m.Kills, m.Deaths) }
+func (m DuelsMode) WLR() float64 { return Ratio(m.Wins, m.Losses) }
+
+func (m DuelsMode) add(o DuelsMode) DuelsMode {
+	m.GamesPlayed += o.GamesPlayed
+	m.Wins += o.Wins
+	m.Losses += o.Losses
+	m.Kills += o.Kills
+	m.Deaths += o.Deaths
+	m.Winstreak = max(m.Winstreak, o.Winstreak)
+	m.BestWinstreak = max(m.BestWinstreak, o.BestWinstreak)
+	return m
+}
+
+// DuelsType modes sharing a title, e.g. every Bridge mode
+type DuelsType struct {
+	Total    DuelsMode
+	Modes    map[string]DuelsMode // keyed by mode id, played modes only
+	Division DuelsDivision
+}
+
+// Duels typed view of player.stats.Duels
+type Duels struct {
+	Overall  DuelsMode
+	Division DuelsDivision // overall title, thresholds doubled
+	Types    map[string]DuelsType
+	Coins    int64
+}
+
+// Duels build the Duels view from the player's stats
+func (p *Player) Duels() Duels {
+	s := p.GameStats("Duels")
+	d := Duels{
+		Overall: DuelsMode{
+			GamesPlayed:   s.Int("games_played_duels"),
+			Wins:          s.Int("wins"),
+			Losses:        s.Int("losses"),
+			Kills:         s.Int("kills"),
+			Deaths:        s.Int("deaths"),
+			Winstreak:     s.Int("current_winstreak"),
+			BestWinstreak: s.Int("best_overall_winstreak"),
+		},
+		Types: map[string]DuelsType{},
+		Coins: s.Int("coins"),
+	}
+	d.Division = DuelsDivisionOf(d.Overall.Wins, true)
+
+	for mode, typ := range DuelsModeTypes {
+		m := duelsMode(s, mode)
+		if m.GamesPlayed == 0 && m.Wins == 0 && m.Losses == 0 {
+			continue
+		}
+		t, ok := d.Types[typ]
+		if !ok {
+			t.Modes = map[string]DuelsMode{}
+		}
+		t.Modes[mode] = m
+		t.Total = t.Total.add(m)
+		d.Types[typ] = t
+	}
+	for name, t := range d.Types {
+		t.Division = DuelsDivisionOf(t.Total.Wins, false)
+		d.Types[name] = t
+	}
+	return d
+}
+
+// duelsMode read one mode, bridge modes store kills and deaths as bridge_kills/bridge_deaths
+func duelsMode(s Stats, mode string) DuelsMode {
+	return DuelsMode{
+		GamesPlayed:   s.Int(mode + "_rounds_played"),
+		Wins:          s.Int(mode + "_wins"),
+		Losses:        s.Int(mode + "_losses"),
+		Kills:         s.Int(mode+"_kills") + s.Int(mode+"_bridge_kills"),
+		Deaths:        s.Int(mode+"_deaths") + s.Int(mode+"_bridge_deaths"),
+		Winstreak:     s.Int("current_winstreak_mode_" + mode),
+		BestWinstreak: s.Int("best_winstreak_mode_" + mode),
+	}
+}
+
+// DuelsDivision a Duels title, e.g. "Gold III"
+type DuelsDivision struct {
+	Name  string // empty when below Rookie I
+	Level int    // 1 based, 0 when below Rookie I
+	Color string
+}
+
+// String impl fmt.Stringer, e.g. "Gold III"
+func (d DuelsDivision) String() string {
+	if d.Name == "" {
+		return ""
+	}
+	return d.Name + " " + Roman(d.Level)
+}
+
+// duelsDivisions per mode win requirements, overall titles need twice as many wins
+var duelsDivisions = []struct {
+	name     string
+	color    string
+	wins     int64 // wins for level I
+	step     int64 // wins per further level
+	maxLevel int
+}{
+	{"Rookie", "§8", 50, 10, 5},
+	{"Iron", "§f", 100, 30, 5},
+	{"Gold", "§6", 250, 50, 5},
+	{"Diamond", "§3", 500, 100, 5},
+	{"Master", "§2", 1000, 200, 5},
+	{"Legend", "§4", 2000, 600, 5},
+	{"Grandmaster", "§e", 5000, 1000, 5},
+	{"Godlike", "§5", 10000, 3000, 5},
+	{"Celestial", "§b", 25000, 5000, 5},
+	{"Divine", "§d", 50000, 10000, 5},
+	{"Ascended", "§c", 100000, 10000, 50},
+}
+
+// DuelsDivisionOf title for wins, overall doubles the requirements
+func DuelsDivisionOf(wins int64, overall bool) DuelsDivision {
+	mul := int64(1)
+	if overall {
+		mul = 2
+	}
+	var d DuelsDivision
+	for _, div := range duelsDivisions {
+		if wins < div.wins*mul {
+			break
+		}
+		level := int((wins-div.wins*mul)/(div.step*mul)) + 1
+		d = DuelsDivision{Name: div.name, Level: min(level, div.maxLevel), Color: div.color}
+	}
+	return d
+}
+
+var romanNumerals = []struct {
+	value int
+	digit string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"}, {100, "C"}, {90, "XC"},
+	{50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// Roman roman numeral of n, empty for n <= 0
+func Roman(n int) string {
+	var b strings.Builder
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			b.WriteString(r.digit)
+			n -= r.value
+		}
+	}
+	return b.String()
+}
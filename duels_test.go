@@ -0,0 +1,64 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDuelsDivisionOf(t *testing.T) {
+	tests := []struct {
+		wins    int64
+		overall bool
+		want    string
+	}{
+		{49, false, ""},
+		{50, false, "Rookie I"},
+		{95, false, "Rookie V"},
+		{260, false, "Gold I"},
+		{360, false, "Gold III"},
+		{260, true, "Iron II"},
+		{9999, false, "Grandmaster V"},
+		{10000, false, "Godlike I"},
+		{600000, false, "Ascended L"},
+	}
+	for _, tt := range tests {
+		if got := DuelsDivisionOf(tt.wins, tt.overall).String(); got != tt.want {
+			t.Errorf("DuelsDivisionOf(%d, %v) = %q; want %q", tt.wins, tt.overall, got, tt.want)
+		}
+	}
+}
+
+func TestRoman(t *testing.T) {
+	for n, want := range map[int]string{0: "", 1: "I", 4: "IV", 9: "IX", 14: "XIV", 50: "L", 1994: "MCMXCIV"} {
+		if got := Roman(n); got != want {
+			t.Errorf("Roman(%d) = %q; want %q", n, got, want)
+		}
+	}
+}
+
+func TestPlayer_Duels(t *testing.T) {
+	p := &Player{Stats: map[string]json.RawMessage{"Duels": json.RawMessage(`{
+		"wins": 600, "losses": 200, "kills": 500, "deaths": 250, "coins": 10,
+		"bridge_duel_wins": 200, "bridge_duel_losses": 50, "bridge_duel_bridge_kills": 300, "bridge_duel_bridge_deaths": 100,
+		"bridge_doubles_wins": 60, "bridge_doubles_rounds_played": 80,
+		"sumo_duel_wins": 340, "sumo_duel_losses": 150,
+		"best_winstreak_mode_sumo_duel": 12
+	}`)}}
+	d := p.Duels()
+	if d.Division.String() != "Gold II" || d.Overall.WLR() != 3 {
+		t.Errorf("overall %+v %s", d.Overall, d.Division)
+	}
+	bridge, ok := d.Types[DuelsBridge]
+	if !ok || len(bridge.Modes) != 2 || bridge.Total.Wins != 260 || bridge.Total.KDR() != 3 {
+		t.Fatalf("bridge = %+v", bridge)
+	}
+	if bridge.Division.String() != "Gold I" {
+		t.Errorf("bridge division = %s", bridge.Division)
+	}
+	if sumo := d.Types[DuelsSumo]; sumo.Division.String() != "Gold II" || sumo.Total.BestWinstreak != 12 {
+		t.Errorf("sumo = %+v", sumo)
+	}
+	if _, ok := d.Types[DuelsUHC]; ok {
+		t.Error("unplayed type present")
+	}
+}
@@ -0,0 +1,43 @@
+package hypixel
+
+import "strings"
+
+// GameInfo one entry of GetGamesInformation
+type GameInfo struct {
+	ID           int               `json:"id"`
+	Name         string            `json:"name"`
+	DatabaseName string            `json:"databaseName"`
+	ModeNames    map[string]string `json:"modeNames"`
+	Retired      bool              `json:"retired"`
+	Legacy       bool              `json:"legacy"`
+}
+
+// Games GetGamesInformation keyed by game type ("BEDWARS", "SKYBLOCK"...)
+type Games map[string]GameInfo
+
+// ParseGames decode a GetGamesInformation response
+func ParseGames(resp Response) (Games, error) {
+	var body struct {
+		Games Games `json:"games"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	return body.Games, nil
+}
+
+// Name display name of gameType, gameType itself when unknown
+func (g Games) Name(gameType string) string {
+	if info, ok := g[strings.ToUpper(gameType)]; ok && info.Name != "" {
+		return info.Name
+	}
+	return gameType
+}
+
+// ModeName display name of a mode of gameType, mode itself when unknown
+func (g Games) ModeName(gameType, mode string) string {
+	if name, ok := g[strings.ToUpper(gameType)].ModeNames[mode]; ok {
+		return name
+	}
+	return mode
+}
@@ -0,0 +1,193 @@
+package hypixel
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Guild typed GetGuild response
+type Guild struct {
+	ID          string             `json:"_id"`
+	Name        string             `json:"name"`
+	Tag         string             `json:"tag"`
+	TagColor    string             `json:"tagColor"`
+	Description string             `json:"description"`
+	Exp         float64            `json:"exp"`
+	Created     int64              `json:"created"`
+	Members     []GuildMember      `json:"members"`
+	Ranks       []GuildRank        `json:"ranks"`
+	GameExp     map[string]float64 `json:"guildExpByGameType"`
+}
+
+// GuildMember one guild member
+type GuildMember struct {
+	UUID               string           `json:"uuid"`
+	Rank               string           `json:"rank"`
+	Joined             int64            `json:"joined"`
+	QuestParticipation int64            `json:"questParticipation"`
+	ExpHistory         map[string]int64 `json:"expHistory"` // "2006-01-02" -> gexp, the last 7 days
+}
+
+// GuildRank a rank defined by the guild
+type GuildRank struct {
+	Name     string `json:"name"`
+	Default  bool   `json:"default"`
+	Tag      string `json:"tag"`
+	Created  int64  `json:"created"`
+	Priority int    `json:"priority"`
+}
+
+// ParseGuild decode a GetGuild response
+// ErrNotFound if no guild matched
+func ParseGuild(resp Response) (*Guild, error) {
+	var body struct {
+		Guild *Guild `json:"guild"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	if body.Guild == nil {
+		return nil, ErrNotFound
+	}
+	return body.Guild, nil
+}
+
+// guildLevelExp exp needed for each of the first levels, every later level costs the last entry
+var guildLevelExp = []float64{
+	100000, 150000, 250000, 500000, 750000,
+	1000000, 1250000, 1500000, 2000000, 2500000,
+	2500000, 2500000, 2500000, 2500000, 3000000,
+}
+
+// Level exact guild level and progress from Exp
+func (g *Guild) Level() LevelProgress {
+	return GuildLevel(g.Exp)
+}
+
+// GuildLevel exact guild level and progress from guild exp, guilds start at level 0
+func GuildLevel(exp float64) LevelProgress {
+	if exp < 0 {
+		exp = 0
+	}
+	level := 0
+	for {
+		span := guildLevelExp[min(level, len(guildLevelExp)-1)]
+		if exp < span {
+			return LevelProgress{
+				Level:    level,
+				Exact:    float64(level) + exp/span,
+				Progress: exp / span,
+				XP:       exp,
+				XPToNext: span,
+			}
+		}
+		exp -= span
+		level++
+	}
+}
+
+// GuildMasterRank name used by the API for the guild owner, it is not listed in Ranks
+const GuildMasterRank = "Guild Master"
+
+// MemberRank resolve the rank of a member
+// The Guild Master gets a priority above every listed rank, unknown ranks get priority 0
+func (g *Guild) MemberRank(m GuildMember) GuildRank {
+	if strings.EqualFold(m.Rank, GuildMasterRank) || strings.EqualFold(m.Rank, "GUILDMASTER") {
+		top := 0
+		for _, r := range g.Ranks {
+			top = max(top, r.Priority)
+		}
+		return GuildRank{Name: GuildMasterRank, Tag: "GM", Priority: top + 1}
+	}
+	for _, r := range g.Ranks {
+		if strings.EqualFold(r.Name, m.Rank) {
+			return r
+		}
+	}
+	return GuildRank{Name: m.Rank}
+}
+
+// WeeklyExp gexp earned over ExpHistory
+func (m GuildMember) WeeklyExp() int64 {
+	var total int64
+	for _, exp := range m.ExpHistory {
+		total += exp
+	}
+	return total
+}
+
+// DailyExp gexp earned on day ("2006-01-02"), the latest day when day is empty
+func (m GuildMember) DailyExp(day string) int64 {
+	if day == "" {
+		for d := range m.ExpHistory {
+			day = max(day, d)
+		}
+	}
+	return m.ExpHistory[day]
+}
+
+// DailyExp total gexp of all members per day
+func (g *Guild) DailyExp() map[string]int64 {
+	days := map[string]int64{}
+	for _, m := range g.Members {
+		for day, exp := range m.ExpHistory {
+			days[day] += exp
+		}
+	}
+	return days
+}
+
+// WeeklyExp total gexp of all members over the history
+func (g *Guild) WeeklyExp() int64 {
+	var total int64
+	for _, m := range g.Members {
+		total += m.WeeklyExp()
+	}
+	return total
+}
+
+// GuildMemberExp a member's place in an exp ranking
+type GuildMemberExp struct {
+	Member   GuildMember
+	Rank     GuildRank
+	Exp      int64
+	Position int // 1 based, tied members share a position
+}
+
+// WeeklyRanking members ordered by weekly gexp, higher rank first on ties
+func (g *Guild) WeeklyRanking() []GuildMemberExp {
+	return g.ranking(GuildMember.WeeklyExp)
+}
+
+// DailyRanking members ordered by gexp earned on day, see GuildMember.DailyExp
+func (g *Guild) DailyRanking(day string) []GuildMemberExp {
+	if day == "" {
+		for d := range g.DailyExp() {
+			day = max(day, d)
+		}
+	}
+	return g.ranking(func(m GuildMember) int64 {
+		return m.ExpHistory[day]
+	})
+}
+
+func (g *Guild) ranking(exp func(GuildMember) int64) []GuildMemberExp {
+	out := make([]GuildMemberExp, 0, len(g.Members))
+	for _, m := range g.Members {
+		out = append(out, GuildMemberExp{Member: m, Rank: g.MemberRank(m), Exp: exp(m)})
+	}
+	slices.SortStableFunc(out, func(a, b GuildMemberExp) int {
+		if c := cmp.Compare(b.Exp, a.Exp); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Rank.Priority, a.Rank.Priority)
+	})
+	for i := range out {
+		out[i].Position = i + 1
+		if i > 0 && out[i].Exp == out[i-1].Exp {
+			out[i].Position = out[i-1].Position
+		}
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package hypixel
+
+import (
+	"errors"
+	"testing"
+)
+
+const testGuild = `{"success":true,"guild":{"_id":"g1","name":"Test","exp":250000,
+	"ranks":[{"name":"Officer","priority":3},{"name":"Member","default":true,"priority":1}],
+	"members":[
+		{"uuid":"a","rank":"Member","expHistory":{"2025-05-17":100,"2025-05-18":50}},
+		{"uuid":"b","rank":"Guild Master","expHistory":{"2025-05-17":0,"2025-05-18":150}},
+		{"uuid":"c","rank":"Officer","expHistory":{"2025-05-17":300,"2025-05-18":10}}
+	]}}`
+
+func TestGuildLevel(t *testing.T) {
+	tests := []struct {
+		exp   float64
+		level int
+	}{
+		{0, 0},
+		{99999, 0},
+		{100000, 1},
+		{250000, 2},
+		{10000000, 10},
+		{15000000, 12},
+		{23000000 + 3000000*3, 18},
+	}
+	for _, tt := range tests {
+		if got := GuildLevel(tt.exp).Level; got != tt.level {
+			t.Errorf("GuildLevel(%v) = %d; want %d", tt.exp, got, tt.level)
+		}
+	}
+}
+
+func TestParseGuild(t *testing.T) {
+	g, err := ParseGuild(Response{Content: []byte(testGuild)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.Level().Level != 2 || len(g.Members) != 3 {
+		t.Errorf("got %+v", g)
+	}
+	if r := g.MemberRank(g.Members[1]); r.Name != GuildMasterRank || r.Priority != 4 {
+		t.Errorf("guild master rank = %+v", r)
+	}
+	if r := g.MemberRank(g.Members[2]); r.Priority != 3 {
+		t.Errorf("officer rank = %+v", r)
+	}
+	if g.WeeklyExp() != 610 || g.DailyExp()["2025-05-18"] != 210 {
+		t.Errorf("weekly=%d daily=%v", g.WeeklyExp(), g.DailyExp())
+	}
+	if got := g.Members[0].DailyExp(""); got != 50 {
+		t.Errorf("latest daily = %d; want 50", got)
+	}
+
+	weekly := g.WeeklyRanking()
+	if weekly[0].Member.UUID != "c" || weekly[1].Member.UUID != "b" || weekly[1].Position != 2 || weekly[2].Position != 2 {
+		t.Errorf("weekly ranking = %+v", weekly)
+	}
+	daily := g.DailyRanking("")
+	if daily[0].Member.UUID != "b" || daily[0].Exp != 150 {
+		t.Errorf("daily ranking = %+v", daily)
+	}
+
+	if _, err := ParseGuild(Response{Content: []byte(`{"success":true,"guild":null}`)}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("null guild: err = %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package hypixel
+
+import "math"
+
+// LevelProgress a level computed from experience
+type LevelProgress struct {
+	Level    int
+	Exact    float64 // Level plus Progress
+	Progress float64 // 0..1 toward the next level, 0 when maxed
+	XP       float64 // experience into the current level
+	XPToNext float64 // experience span of the current level, 0 when maxed
+	Overflow float64 // experience past the max level
+	Maxed    bool
+}
+
+// network leveling: level n to n+1 costs networkBase + networkGrowth*(n-1)
+const (
+	networkBase   = 10000.0
+	networkGrowth = 2500.0
+)
+
+// NetworkLevel exact Hypixel network level from networkExp
+func NetworkLevel(exp float64) LevelProgress {
+	if exp < 0 {
+		exp = 0
+	}
+	// inverse of networkExpToLevel
+	exact := 1 - 3.5 + math.Sqrt(12.25+0.0008*exp)
+	level := int(math.Floor(exact))
+	// float error can put exp just below the floor's threshold
+	for level > 1 && networkExpToLevel(level) > exp {
+		level--
+	}
+	for networkExpToLevel(level+1) <= exp {
+		level++
+	}
+	into := exp - networkExpToLevel(level)
+	span := networkBase + networkGrowth*float64(level-1)
+	return LevelProgress{
+		Level:    level,
+		Exact:    float64(level) + into/span,
+		Progress: into / span,
+		XP:       into,
+		XPToNext: span,
+	}
+}
+
+// networkExpToLevel total experience needed to reach level
+func networkExpToLevel(level int) float64 {
+	if level <= 1 {
+		return 0
+	}
+	l := float64(level)
+	return (l - 1) * (networkBase + networkGrowth/2*(l-2))
+}
+
+// tableLevel level from a cumulative table, total[i] is the experience needed to reach level i and total[0] is 0
+// maxLevel caps the level (0 means len(total)-1), experience past it is Overflow
+func tableLevel(total []float64, exp float64, maxLevel int) LevelProgress {
+	if maxLevel <= 0 || maxLevel >= len(total) {
+		maxLevel = len(total) - 1
+	}
+	exp = max(exp, 0)
+	level := 0
+	for level < maxLevel && exp >= total[level+1] {
+		level++
+	}
+	into := exp - total[level]
+	if level == maxLevel {
+		return LevelProgress{
+			Level:    level,
+			Exact:    float64(level),
+			XP:       into,
+			Overflow: into,
+			Maxed:    true,
+		}
+	}
+	span := total[level+1] - total[level]
+	return LevelProgress{
+		Level:    level,
+		Exact:    float64(level) + into/span,
+		Progress: into / span,
+		XP:       into,
+		XPToNext: span,
+	}
+}
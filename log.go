@@ -0,0 +1,89 @@
+package hypixel
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+)
+
+// LogLevels slog levels used by Client.Get
+type LogLevels struct {
+	Request slog.Level // successful requests and hook hits
+	Failure slog.Level // responses with status >= 400
+	Error   slog.Level // requests that returned an error
+}
+
+// DefaultLogLevels Debug for requests, Warn for failed status and Error for errors
+func DefaultLogLevels() LogLevels {
+	return LogLevels{
+		Request: slog.LevelDebug,
+		Failure: slog.LevelWarn,
+		Error:   slog.LevelError,
+	}
+}
+
+// sensitive query keys, compared case-insensitively
+var redactedParams = []string{"key", "api-key", "api_key", "apikey"}
+
+// redactQuery returns the query of rawURL with api keys masked
+func redactQuery(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	q := u.Query()
+	for k := range q {
+		for _, s := range redactedParams {
+			if strings.EqualFold(k, s) {
+				q.Set(k, "REDACTED")
+			}
+		}
+	}
+	return q.Encode()
+}
+
+// logRequest write one record for a finished Get call
+// Headers are never logged, they carry the api key
+func (c *Client) logRequest(r Request, resp Response, err error) {
+	logger := c.GetLogger()
+	if logger == nil {
+		return
+	}
+	levels := c.GetLogLevels()
+	level := levels.Request
+	switch {
+	case err != nil:
+		level = levels.Error
+	case resp.Status >= 400:
+		level = levels.Failure
+	}
+	ctx := context.Background()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	query := redactQuery(r.URL)
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.String("path", r.Path),
+		slog.String("query", query),
+		slog.Int("status", resp.Status),
+		slog.Duration("latency", resp.Meta.Duration),
+		slog.Int("bytes", resp.size()),
+		slog.String("cache", resp.Meta.cacheStatus()),
+		slog.Int("attempt", resp.Meta.Attempts),
+	}
+	if resp.Meta.RateRemaining >= 0 {
+		attrs = append(attrs, slog.Int("rate_remaining", int(resp.Meta.RateRemaining)))
+	}
+	if err != nil {
+		// *url.Error embeds the full url
+		msg := err.Error()
+		if i := strings.IndexByte(r.URL, '?'); i >= 0 {
+			msg = strings.ReplaceAll(msg, r.URL, r.URL[:i+1]+query)
+		}
+		attrs = append(attrs, slog.String("error", msg))
+	}
+	logger.LogAttrs(ctx, level, "hypixel request", attrs...)
+}
@@ -0,0 +1,71 @@
+package hypixel
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedactQuery(t *testing.T) {
+	got := redactQuery("https://api.hypixel.net/v2/player?uuid=abc&key=secret")
+	if strings.Contains(got, "secret") {
+		t.Errorf("redactQuery() = %q; leaked key", got)
+	}
+	if !strings.Contains(got, "uuid=abc") {
+		t.Errorf("redactQuery() = %q; want uuid kept", got)
+	}
+}
+
+func TestClient_Logger(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "42")
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	c := NewClient("secret-key", NewRateLimit())
+	c.SetBaseURL(srv.URL)
+	c.SetLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	if _, err := c.Get(Request{Header: c.AuthHeader(), Path: "player", Params: Params{"uuid": "abc", "key": "secret-key"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "secret-key") {
+		t.Errorf("log leaked api key: %s", out)
+	}
+	for _, want := range []string{"method=GET", "path=player", "status=200", "bytes=16", "cache=miss", "attempt=1", "rate_remaining=42"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log = %q; want to contain %q", out, want)
+		}
+	}
+
+	buf.Reset()
+	c.SetPreRequestHook(func(Request) (Response, error) {
+		return Response{Status: 200}, nil
+	})
+	if _, err := c.Get(Request{Path: "player"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "cache=hit") {
+		t.Errorf("log = %q; want cache=hit", buf.String())
+	}
+
+	buf.Reset()
+	c.SetPreRequestHook(func(Request) (Response, error) {
+		return Response{}, errors.New("miss")
+	})
+	c.SetBaseURL("http://127.0.0.1:0")
+	if _, err := c.Get(Request{Path: "player", Params: Params{"key": "secret-key"}}); err == nil {
+		t.Fatal("expected error")
+	}
+	out = buf.String()
+	if !strings.Contains(out, "level=ERROR") || strings.Contains(out, "secret-key") {
+		t.Errorf("log = %q; want redacted error record", out)
+	}
+}
@@ -0,0 +1,142 @@
+package hypixel
+
+import (
+	"expvar"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RequestMetric one finished Client.Get call
+type RequestMetric struct {
+	Path     string
+	Status   int // 0 when the request failed before a response
+	Latency  time.Duration
+	Size     int // response content length
+	Retries  int // attempts after the first one
+	CacheHit bool
+	Err      error
+}
+
+// Metrics receives measurements from Client.Get
+// Back it with prometheus client, expvar or anything else, the core package does not depend on them
+type Metrics interface {
+	// ObserveRequest called once per Get call
+	ObserveRequest(m RequestMetric)
+	// ObserveRateLimit called after every network response with the current RateLimit state
+	ObserveRateLimit(remaining int32, untilReset time.Duration)
+}
+
+// observeRequest report a finished Get call to the configured Metrics
+func (c *Client) observeRequest(r Request, resp Response, err error) {
+	m := c.GetMetrics()
+	if m == nil {
+		return
+	}
+	m.ObserveRequest(RequestMetric{
+		Path:     r.Path,
+		Status:   resp.Status,
+		Latency:  resp.Meta.Duration,
+		Size:     resp.size(),
+		Retries:  resp.Meta.Retries(),
+		CacheHit: resp.Meta.Source == SourceHook,
+		Err:      err,
+	})
+	if resp.Meta.RateRemaining >= 0 {
+		m.ObserveRateLimit(resp.Meta.RateRemaining, resp.Meta.UntilReset())
+	}
+}
+
+// DefaultLatencyBuckets histogram upper bounds in seconds
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// DefaultSizeBuckets histogram upper bounds in bytes
+var DefaultSizeBuckets = []float64{1 << 10, 16 << 10, 128 << 10, 1 << 20, 8 << 20, 32 << 20}
+
+// ExpvarMetrics Metrics implementation published through expvar
+//
+// Layout under the published name:
+//
+//	requests           "path status" -> count
+//	latency_seconds    path -> histogram
+//	response_bytes     path -> histogram
+//	retries            path -> count
+//	cache_hits         path -> count
+//	rate_remaining     gauge
+//	rate_reset_seconds gauge
+//
+// A histogram is a map with cumulative "le_<bound>" buckets, "+Inf", "count" and "sum".
+type ExpvarMetrics struct {
+	mu               sync.Mutex // protects histogram creation
+	requests         *expvar.Map
+	latency          *expvar.Map
+	size             *expvar.Map
+	retries          *expvar.Map
+	cacheHits        *expvar.Map
+	rateRemaining    *expvar.Int
+	rateResetSeconds *expvar.Float
+}
+
+// NewExpvarMetrics publish the metrics under name
+// Like expvar.Publish, it panics if name is already registered
+func NewExpvarMetrics(name string) *ExpvarMetrics {
+	m := &ExpvarMetrics{
+		requests:         new(expvar.Map),
+		latency:          new(expvar.Map),
+		size:             new(expvar.Map),
+		retries:          new(expvar.Map),
+		cacheHits:        new(expvar.Map),
+		rateRemaining:    new(expvar.Int),
+		rateResetSeconds: new(expvar.Float),
+	}
+	root := expvar.NewMap(name)
+	root.Set("requests", m.requests)
+	root.Set("latency_seconds", m.latency)
+	root.Set("response_bytes", m.size)
+	root.Set("retries", m.retries)
+	root.Set("cache_hits", m.cacheHits)
+	root.Set("rate_remaining", m.rateRemaining)
+	root.Set("rate_reset_seconds", m.rateResetSeconds)
+	m.rateRemaining.Set(-1)
+	return m
+}
+
+func (m *ExpvarMetrics) ObserveRequest(r RequestMetric) {
+	m.requests.Add(r.Path+" "+strconv.Itoa(r.Status), 1)
+	observeHistogram(m.histogram(m.latency, r.Path), DefaultLatencyBuckets, r.Latency.Seconds())
+	observeHistogram(m.histogram(m.size, r.Path), DefaultSizeBuckets, float64(r.Size))
+	if r.Retries > 0 {
+		m.retries.Add(r.Path, int64(r.Retries))
+	}
+	if r.CacheHit {
+		m.cacheHits.Add(r.Path, 1)
+	}
+}
+
+func (m *ExpvarMetrics) ObserveRateLimit(remaining int32, untilReset time.Duration) {
+	m.rateRemaining.Set(int64(remaining))
+	m.rateResetSeconds.Set(untilReset.Seconds())
+}
+
+// histogram get or create the histogram map for key
+func (m *ExpvarMetrics) histogram(parent *expvar.Map, key string) *expvar.Map {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if h, ok := parent.Get(key).(*expvar.Map); ok {
+		return h
+	}
+	h := new(expvar.Map)
+	parent.Set(key, h)
+	return h
+}
+
+func observeHistogram(h *expvar.Map, buckets []float64, v float64) {
+	for _, b := range buckets {
+		if v <= b {
+			h.Add("le_"+strconv.FormatFloat(b, 'g', -1, 64), 1)
+		}
+	}
+	h.Add("+Inf", 1)
+	h.Add("count", 1)
+	h.AddFloat("sum", v)
+}
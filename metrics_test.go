@@ -0,0 +1,73 @@
+package hypixel
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordMetrics struct {
+	mu        sync.Mutex
+	requests  []RequestMetric
+	remaining int32
+	reset     time.Duration
+}
+
+func (m *recordMetrics) ObserveRequest(r RequestMetric) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, r)
+}
+
+func (m *recordMetrics) ObserveRateLimit(remaining int32, untilReset time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.remaining = remaining
+	m.reset = untilReset
+}
+
+func TestClient_Metrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "7")
+		w.Header().Set("RateLimit-Reset", "30")
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	defer srv.Close()
+
+	m := &recordMetrics{}
+	c := NewClient("key", NewRateLimit())
+	c.SetBaseURL(srv.URL)
+	c.SetMetrics(m)
+
+	if _, err := c.Get(Request{Path: "counts"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(m.requests) != 1 {
+		t.Fatalf("got %d observations; want 1", len(m.requests))
+	}
+	got := m.requests[0]
+	if got.Path != "counts" || got.Status != 200 || got.Size != 16 || got.CacheHit || got.Retries != 0 {
+		t.Errorf("ObserveRequest got %+v", got)
+	}
+	if m.remaining != 7 || m.reset <= 0 || m.reset > 30*time.Second {
+		t.Errorf("ObserveRateLimit got remaining=%d reset=%v", m.remaining, m.reset)
+	}
+}
+
+func TestExpvarMetrics(t *testing.T) {
+	m := NewExpvarMetrics("hypixel_test")
+	m.ObserveRequest(RequestMetric{Path: "player", Status: 200, Latency: 80 * time.Millisecond, Size: 2048})
+	m.ObserveRequest(RequestMetric{Path: "player", Status: 200, CacheHit: true})
+	m.ObserveRateLimit(12, 3*time.Second)
+
+	out := expvar.Get("hypixel_test").String()
+	for _, want := range []string{`"player 200": 2`, `"cache_hits": {"player": 1}`, `"rate_remaining": 12`, `"le_0.1": 2`, `"le_0.05": 1`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expvar = %s; want to contain %s", out, want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// ErrNotFound the API answered success but the requested object is null
+var ErrNotFound = errors.New("hypixel: not found")
+
+// APIError the API answered success false
+type APIError struct {
+	Status int
+	Cause  string
+}
+
+func (e *APIError) Error() string {
+	return "hypixel: " + strconv.Itoa(e.Status) + " " + e.Cause
+}
+
+// decodeResponse check the success flag of resp and unmarshal its content into v
+func decodeResponse(resp Response, v any) error {
+	var env struct {
+		Success bool   `json:"success"`
+		Cause   string `json:"cause"`
+	}
+	if err := json.Unmarshal(resp.Content, &env); err != nil {
+		return err
+	}
+	if !env.Success {
+		return &APIError{Status: resp.Status, Cause: env.Cause}
+	}
+	return json.Unmarshal(resp.Content, v)
+}
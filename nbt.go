@@ -0,0 +1,236 @@
+package hypixel
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// NBT tag types
+const (
+	nbtEnd byte = iota
+	nbtByte
+	nbtShort
+	nbtInt
+	nbtLong
+	nbtFloat
+	nbtDouble
+	nbtByteArray
+	nbtString
+	nbtList
+	nbtCompound
+	nbtIntArray
+	nbtLongArray
+)
+
+// nbtMaxDepth nesting limit, item data never comes close
+const nbtMaxDepth = 512
+
+// ReadNBT decode one uncompressed big endian NBT tag, as used by Minecraft items
+// Compounds become map[string]any, lists []any, and numbers keep their NBT width
+// (int8, int16, int32, int64, float32, float64). Byte, int and long arrays become
+// []byte, []int32 and []int64
+func ReadNBT(r io.Reader) (name string, v any, err error) {
+	d := nbtDecoder{r: bufio.NewReader(r)}
+	t, err := d.byte()
+	if err != nil {
+		return "", nil, err
+	}
+	if t == nbtEnd {
+		return "", nil, nil
+	}
+	if name, err = d.string(); err != nil {
+		return "", nil, err
+	}
+	v, err = d.payload(t, 0)
+	return name, v, err
+}
+
+type nbtDecoder struct {
+	r   *bufio.Reader
+	buf [8]byte
+}
+
+func (d *nbtDecoder) read(n int) ([]byte, error) {
+	if _, err := io.ReadFull(d.r, d.buf[:n]); err != nil {
+		return nil, err
+	}
+	return d.buf[:n], nil
+}
+
+func (d *nbtDecoder) byte() (byte, error) {
+	return d.r.ReadByte()
+}
+
+func (d *nbtDecoder) int16() (int16, error) {
+	b, err := d.read(2)
+	if err != nil {
+		return 0, err
+	}
+	return int16(binary.BigEndian.Uint16(b)), nil
+}
+
+func (d *nbtDecoder) int32() (int32, error) {
+	b, err := d.read(4)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.BigEndian.Uint32(b)), nil
+}
+
+func (d *nbtDecoder) int64() (int64, error) {
+	b, err := d.read(8)
+	if err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(b)), nil
+}
+
+func (d *nbtDecoder) length() (int, error) {
+	n, err := d.int32()
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("hypixel: negative nbt length %d", n)
+	}
+	return int(n), nil
+}
+
+func (d *nbtDecoder) string() (string, error) {
+	n, err := d.int16()
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, uint16(n))
+	if _, err := io.ReadFull(d.r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (d *nbtDecoder) payload(t byte, depth int) (any, error) {
+	if depth > nbtMaxDepth {
+		return nil, errors.New("hypixel: nbt nested too deep")
+	}
+	switch t {
+	case nbtByte:
+		b, err := d.byte()
+		return int8(b), err
+	case nbtShort:
+		return d.int16()
+	case nbtInt:
+		return d.int32()
+	case nbtLong:
+		return d.int64()
+	case nbtFloat:
+		n, err := d.int32()
+		return math.Float32frombits(uint32(n)), err
+	case nbtDouble:
+		n, err := d.int64()
+		return math.Float64frombits(uint64(n)), err
+	case nbtByteArray:
+		n, err := d.length()
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, 0, min(n, 1<<16))
+		for range n {
+			c, err := d.byte()
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, c)
+		}
+		return b, nil
+	case nbtString:
+		return d.string()
+	case nbtList:
+		et, err := d.byte()
+		if err != nil {
+			return nil, err
+		}
+		n, err := d.length()
+		if err != nil {
+			return nil, err
+		}
+		list := make([]any, 0, min(n, 1<<10))
+		for range n {
+			v, err := d.payload(et, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case nbtCompound:
+		m := map[string]any{}
+		for {
+			et, err := d.byte()
+			if err != nil {
+				return nil, err
+			}
+			if et == nbtEnd {
+				return m, nil
+			}
+			name, err := d.string()
+			if err != nil {
+				return nil, err
+			}
+			if m[name], err = d.payload(et, depth+1); err != nil {
+				return nil, err
+			}
+		}
+	case nbtIntArray:
+		n, err := d.length()
+		if err != nil {
+			return nil, err
+		}
+		a := make([]int32, 0, min(n, 1<<14))
+		for range n {
+			v, err := d.int32()
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, v)
+		}
+		return a, nil
+	case nbtLongArray:
+		n, err := d.length()
+		if err != nil {
+			return nil, err
+		}
+		a := make([]int64, 0, min(n, 1<<13))
+		for range n {
+			v, err := d.int64()
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, v)
+		}
+		return a, nil
+	}
+	return nil, fmt.Errorf("hypixel: unknown nbt tag %d", t)
+}
+
+// nbtNumber any NBT integer or float as int64
+func nbtNumber(v any) (int64, bool) {
+	switch v := v.(type) {
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case float32:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,162 @@
+package hypixel
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// writeNBT encode v as the payload of a tag, the tag type comes from the Go type
+func writeNBT(buf *bytes.Buffer, v any) byte {
+	be := binary.BigEndian
+	switch v := v.(type) {
+	case int8:
+		buf.WriteByte(byte(v))
+		return nbtByte
+	case int16:
+		buf.Write(be.AppendUint16(nil, uint16(v)))
+		return nbtShort
+	case int32:
+		buf.Write(be.AppendUint32(nil, uint32(v)))
+		return nbtInt
+	case int64:
+		buf.Write(be.AppendUint64(nil, uint64(v)))
+		return nbtLong
+	case float32:
+		buf.Write(be.AppendUint32(nil, math.Float32bits(v)))
+		return nbtFloat
+	case float64:
+		buf.Write(be.AppendUint64(nil, math.Float64bits(v)))
+		return nbtDouble
+	case []byte:
+		buf.Write(be.AppendUint32(nil, uint32(len(v))))
+		buf.Write(v)
+		return nbtByteArray
+	case string:
+		buf.Write(be.AppendUint16(nil, uint16(len(v))))
+		buf.WriteString(v)
+		return nbtString
+	case []any:
+		var body bytes.Buffer
+		et := nbtEnd
+		for _, e := range v {
+			et = writeNBT(&body, e)
+		}
+		buf.WriteByte(et)
+		buf.Write(be.AppendUint32(nil, uint32(len(v))))
+		buf.Write(body.Bytes())
+		return nbtList
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			var body bytes.Buffer
+			t := writeNBT(&body, v[k])
+			buf.WriteByte(t)
+			writeNBT(buf, k)
+			buf.Write(body.Bytes())
+		}
+		buf.WriteByte(nbtEnd)
+		return nbtCompound
+	case []int32:
+		buf.Write(be.AppendUint32(nil, uint32(len(v))))
+		for _, e := range v {
+			buf.Write(be.AppendUint32(nil, uint32(e)))
+		}
+		return nbtIntArray
+	case []int64:
+		buf.Write(be.AppendUint32(nil, uint32(len(v))))
+		for _, e := range v {
+			buf.Write(be.AppendUint64(nil, uint64(e)))
+		}
+		return nbtLongArray
+	}
+	panic("writeNBT: unsupported type")
+}
+
+// nbtRoot encode v as a named root tag
+func nbtRoot(name string, v any) []byte {
+	var body, out bytes.Buffer
+	t := writeNBT(&body, v)
+	out.WriteByte(t)
+	writeNBT(&out, name)
+	out.Write(body.Bytes())
+	return out.Bytes()
+}
+
+// encodeItems encode slots like the API does, nil slots are empty
+func encodeItems(slots ...map[string]any) string {
+	list := make([]any, 0, len(slots))
+	for _, s := range slots {
+		if s == nil {
+			s = map[string]any{}
+		}
+		list = append(list, s)
+	}
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write(nbtRoot("", map[string]any{"i": list}))
+	_ = zw.Close()
+	return base64.StdEncoding.EncodeToString(gz.Bytes())
+}
+
+// testItem an item compound with a SkyBlock id
+func testItem(id, name string, extra map[string]any, lore ...string) map[string]any {
+	attrs := map[string]any{"id": id}
+	for k, v := range extra {
+		attrs[k] = v
+	}
+	loreList := make([]any, 0, len(lore))
+	for _, l := range lore {
+		loreList = append(loreList, l)
+	}
+	return map[string]any{
+		"id":     int16(1),
+		"Count":  int8(1),
+		"Damage": int16(0),
+		"tag": map[string]any{
+			"display":         map[string]any{"Name": name, "Lore": loreList},
+			"ExtraAttributes": attrs,
+		},
+	}
+}
+
+func TestReadNBT(t *testing.T) {
+	want := map[string]any{
+		"byte":   int8(-1),
+		"short":  int16(300),
+		"int":    int32(70000),
+		"long":   int64(1 << 40),
+		"float":  float32(1.5),
+		"double": 2.25,
+		"bytes":  []byte{1, 2},
+		"str":    "héllo",
+		"list":   []any{int32(1), int32(2)},
+		"empty":  []any{},
+		"nested": map[string]any{"a": "b"},
+		"ints":   []int32{1, -1},
+		"longs":  []int64{5},
+	}
+	name, got, err := ReadNBT(bytes.NewReader(nbtRoot("root", want)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "root" || !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadNBT = %q %#v", name, got)
+	}
+
+	if _, _, err := ReadNBT(bytes.NewReader([]byte{nbtCompound, 0, 0, nbtInt, 0})); err == nil {
+		t.Error("expected error on truncated input")
+	}
+	if _, _, err := ReadNBT(bytes.NewReader([]byte{nbtList, 0, 0, nbtInt, 0xff, 0xff, 0xff, 0xff})); err == nil {
+		t.Error("expected error on negative length")
+	}
+}
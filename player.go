@@ -0,0 +1,209 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Player typed subset of GetPlayerData
+// Stats keeps each game's raw stats object, see the game specific views
+type Player struct {
+	UUID                string                     `json:"uuid"`
+	DisplayName         string                     `json:"displayname"`
+	NetworkExp          float64                    `json:"networkExp"`
+	Karma               int64                      `json:"karma"`
+	Rank                string                     `json:"rank"`
+	PackageRank         string                     `json:"packageRank"`
+	NewPackageRank      string                     `json:"newPackageRank"`
+	MonthlyPackageRank  string                     `json:"monthlyPackageRank"`
+	RankPlusColor       string                     `json:"rankPlusColor"`
+	MonthlyRankColor    string                     `json:"monthlyRankColor"`
+	Prefix              string                     `json:"prefix"`
+	FirstLogin          int64                      `json:"firstLogin"`
+	LastLogin           int64                      `json:"lastLogin"`
+	LastLogout          int64                      `json:"lastLogout"`
+	AchievementsOneTime StringList                 `json:"achievementsOneTime"`
+	Achievements        map[string]int64           `json:"achievements"` // tiered achievement progress
+	Quests              map[string]PlayerQuest     `json:"quests"`
+	Challenges          PlayerChallenges           `json:"challenges"`
+	Stats               map[string]json.RawMessage `json:"stats"`
+}
+
+// StringList []string that skips non string elements,
+// achievementsOneTime of some old accounts holds stray empty arrays
+type StringList []string
+
+func (l *StringList) UnmarshalJSON(data []byte) error {
+	var raw []any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	out := make(StringList, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	*l = out
+	return nil
+}
+
+// ParsePlayer decode a GetPlayerData response
+// ErrNotFound if the player never joined Hypixel
+func ParsePlayer(resp Response) (*Player, error) {
+	var body struct {
+		Player *Player `json:"player"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	if body.Player == nil {
+		return nil, ErrNotFound
+	}
+	return body.Player, nil
+}
+
+// Level exact network level and progress from NetworkExp
+func (p *Player) Level() LevelProgress {
+	return NetworkLevel(p.NetworkExp)
+}
+
+// Rank ids returned by EffectiveRank
+const (
+	RankNone      = "NONE"
+	RankVIP       = "VIP"
+	RankVIPPlus   = "VIP_PLUS"
+	RankMVP       = "MVP"
+	RankMVPPlus   = "MVP_PLUS"
+	RankSuperstar = "SUPERSTAR" // MVP++
+	RankYouTuber  = "YOUTUBER"
+	RankHelper    = "HELPER"
+	RankModerator = "MODERATOR"
+	RankGM        = "GAME_MASTER"
+	RankAdmin     = "ADMIN"
+	RankOwner     = "OWNER"
+	RankStaff     = "STAFF"  // current rank of all Hypixel staff
+	RankCustom    = "CUSTOM" // prefix field set
+)
+
+// DisplayedRank the rank shown in front of a player's name
+type DisplayedRank struct {
+	ID     string // one of the Rank constants
+	Name   string // "MVP++", "YOUTUBE"...
+	Prefix string // colour coded, e.g. "§6[MVP§c++§6]", "§7" for no rank
+	Staff  bool
+}
+
+// String impl fmt.Stringer, prefix without colour codes
+func (r DisplayedRank) String() string {
+	return StripColor(r.Prefix)
+}
+
+// staff and special ranks stored in the rank field
+var specialRanks = map[string]DisplayedRank{
+	RankStaff:     {ID: RankStaff, Name: "STAFF", Prefix: "§c[§6ዞ§c]", Staff: true},
+	RankOwner:     {ID: RankOwner, Name: "OWNER", Prefix: "§c[OWNER]", Staff: true},
+	RankAdmin:     {ID: RankAdmin, Name: "ADMIN", Prefix: "§c[ADMIN]", Staff: true},
+	RankGM:        {ID: RankGM, Name: "GM", Prefix: "§2[GM]", Staff: true},
+	RankModerator: {ID: RankModerator, Name: "MOD", Prefix: "§2[MOD]", Staff: true},
+	RankHelper:    {ID: RankHelper, Name: "HELPER", Prefix: "§9[HELPER]", Staff: true},
+	RankYouTuber:  {ID: RankYouTuber, Name: "YOUTUBE", Prefix: "§c[§fYOUTUBE§c]"},
+}
+
+// EffectiveRank resolve the displayed rank
+// Precedence: custom prefix, staff/YouTuber rank, MVP++, newPackageRank, legacy packageRank.
+func (p *Player) EffectiveRank() DisplayedRank {
+	if p.Prefix != "" {
+		return DisplayedRank{ID: RankCustom, Name: strings.Trim(StripColor(p.Prefix), "[] "), Prefix: p.Prefix}
+	}
+	if r, ok := specialRanks[p.Rank]; ok {
+		return r
+	}
+
+	plus := ColorCode(p.RankPlusColor)
+	if plus == "" {
+		plus = "§c"
+	}
+	if p.MonthlyPackageRank == RankSuperstar {
+		base := "§6"
+		if p.MonthlyRankColor == "AQUA" {
+			base = "§b"
+		}
+		return DisplayedRank{ID: RankSuperstar, Name: "MVP++", Prefix: base + "[MVP" + plus + "++" + base + "]"}
+	}
+
+	pkg := p.NewPackageRank
+	if pkg == "" || pkg == RankNone {
+		pkg = p.PackageRank
+	}
+	switch pkg {
+	case RankMVPPlus:
+		return DisplayedRank{ID: RankMVPPlus, Name: "MVP+", Prefix: "§b[MVP" + plus + "+§b]"}
+	case RankMVP:
+		return DisplayedRank{ID: RankMVP, Name: "MVP", Prefix: "§b[MVP]"}
+	case RankVIPPlus:
+		return DisplayedRank{ID: RankVIPPlus, Name: "VIP+", Prefix: "§a[VIP§6+§a]"}
+	case RankVIP:
+		return DisplayedRank{ID: RankVIP, Name: "VIP", Prefix: "§a[VIP]"}
+	}
+	return DisplayedRank{ID: RankNone, Prefix: "§7"}
+}
+
+// FormattedName rank prefix followed by the display name, colour coded
+func (p *Player) FormattedName() string {
+	r := p.EffectiveRank()
+	if r.ID == RankNone {
+		return r.Prefix + p.DisplayName
+	}
+	// the name takes the colour the prefix starts with
+	color := ""
+	if strings.HasPrefix(r.Prefix, "§") && len(r.Prefix) >= len("§c") {
+		color = r.Prefix[:len("§c")]
+	}
+	return r.Prefix + " " + color + p.DisplayName
+}
+
+// minecraft colour names used by the API, e.g. rankPlusColor
+var colorCodes = map[string]string{
+	"BLACK":        "§0",
+	"DARK_BLUE":    "§1",
+	"DARK_GREEN":   "§2",
+	"DARK_AQUA":    "§3",
+	"DARK_RED":     "§4",
+	"DARK_PURPLE":  "§5",
+	"GOLD":         "§6",
+	"GRAY":         "§7",
+	"DARK_GRAY":    "§8",
+	"BLUE":         "§9",
+	"GREEN":        "§a",
+	"AQUA":         "§b",
+	"RED":          "§c",
+	"LIGHT_PURPLE": "§d",
+	"YELLOW":       "§e",
+	"WHITE":        "§f",
+}
+
+// ColorCode section sign code of a colour name ("RED" -> "§c"), empty if unknown
+func ColorCode(name string) string {
+	return colorCodes[strings.ToUpper(name)]
+}
+
+// StripColor remove § formatting codes
+func StripColor(s string) string {
+	if !strings.Contains(s, "§") {
+		return s
+	}
+	var b strings.Builder
+	skip := false
+	for _, r := range s {
+		switch {
+		case skip:
+			skip = false
+		case r == '§':
+			skip = true
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
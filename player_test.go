@@ -0,0 +1,95 @@
+package hypixel
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNetworkLevel(t *testing.T) {
+	tests := []struct {
+		exp   float64
+		level int
+		prog  float64
+	}{
+		{0, 1, 0},
+		{5000, 1, 0.5},
+		{10000, 2, 0},
+		{22500, 3, 0},
+		{24000, 3, 0.1},
+		{-5, 1, 0},
+	}
+	for _, tt := range tests {
+		got := NetworkLevel(tt.exp)
+		if got.Level != tt.level || math.Abs(got.Progress-tt.prog) > 1e-9 {
+			t.Errorf("NetworkLevel(%v) = %d %.4f; want %d %.4f", tt.exp, got.Level, got.Progress, tt.level, tt.prog)
+		}
+	}
+	// every threshold lands exactly on its level
+	for l := 2; l < 300; l++ {
+		if got := NetworkLevel(networkExpToLevel(l)).Level; got != l {
+			t.Fatalf("NetworkLevel(threshold %d) = %d", l, got)
+		}
+	}
+}
+
+func TestParsePlayer(t *testing.T) {
+	p, err := ParsePlayer(Response{Status: 200, Content: []byte(`{"success":true,"player":{"displayname":"Steve","networkExp":22500,"karma":120,"newPackageRank":"MVP_PLUS","rankPlusColor":"DARK_GREEN"}}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.DisplayName != "Steve" || p.Karma != 120 || p.Level().Level != 3 {
+		t.Errorf("got %+v", p)
+	}
+	if got := p.FormattedName(); got != "§b[MVP§2+§b] §bSteve" {
+		t.Errorf("FormattedName() = %q", got)
+	}
+
+	if _, err := ParsePlayer(Response{Content: []byte(`{"success":true,"player":null}`)}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("null player: err = %v; want ErrNotFound", err)
+	}
+	var apiErr *APIError
+	if _, err := ParsePlayer(Response{Status: 403, Content: []byte(`{"success":false,"cause":"Invalid API key"}`)}); !errors.As(err, &apiErr) || apiErr.Cause != "Invalid API key" {
+		t.Errorf("failure: err = %v; want APIError", err)
+	}
+}
+
+func TestEffectiveRank(t *testing.T) {
+	tests := []struct {
+		name   string
+		player Player
+		id     string
+		prefix string
+	}{
+		{"none", Player{}, RankNone, "§7"},
+		{"legacy vip", Player{PackageRank: "VIP"}, RankVIP, "§a[VIP]"},
+		{"vip plus", Player{NewPackageRank: "VIP_PLUS"}, RankVIPPlus, "§a[VIP§6+§a]"},
+		{"mvp plus default colour", Player{NewPackageRank: "MVP_PLUS"}, RankMVPPlus, "§b[MVP§c+§b]"},
+		{"superstar", Player{NewPackageRank: "MVP_PLUS", MonthlyPackageRank: "SUPERSTAR", RankPlusColor: "BLACK"}, RankSuperstar, "§6[MVP§0++§6]"},
+		{"superstar aqua", Player{MonthlyPackageRank: "SUPERSTAR", MonthlyRankColor: "AQUA"}, RankSuperstar, "§b[MVP§c++§b]"},
+		{"youtuber", Player{Rank: "YOUTUBER", NewPackageRank: "MVP_PLUS"}, RankYouTuber, "§c[§fYOUTUBE§c]"},
+		{"admin", Player{Rank: "ADMIN", MonthlyPackageRank: "SUPERSTAR"}, RankAdmin, "§c[ADMIN]"},
+		{"staff", Player{Rank: "STAFF", MonthlyPackageRank: "SUPERSTAR"}, RankStaff, "§c[§6ዞ§c]"},
+		{"custom prefix", Player{Rank: "ADMIN", Prefix: "§d[PIG§b+++§d]"}, RankCustom, "§d[PIG§b+++§d]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.player.EffectiveRank()
+			if got.ID != tt.id || got.Prefix != tt.prefix {
+				t.Errorf("EffectiveRank() = %s %q; want %s %q", got.ID, got.Prefix, tt.id, tt.prefix)
+			}
+		})
+	}
+	if !(&Player{Rank: "STAFF"}).EffectiveRank().Staff {
+		t.Error("STAFF should be Staff")
+	}
+	if got := (&Player{Prefix: "§d[PIG§b+++§d]"}).EffectiveRank().Name; got != "PIG+++" {
+		t.Errorf("custom Name = %q; want PIG+++", got)
+	}
+}
+
+func TestStripColor(t *testing.T) {
+	if got := StripColor("§6[MVP§c++§6]"); got != "[MVP++]" {
+		t.Errorf("StripColor() = %q", got)
+	}
+}
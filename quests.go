@@ -0,0 +1,262 @@
+package hypixel
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// QuestReward reward of a quest or challenge
+type QuestReward struct {
+	Type   string `json:"type"`
+	Amount int64  `json:"amount"`
+}
+
+// QuestObjective an objective of a quest
+type QuestObjective struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Integer int64  `json:"integer"` // target of IntegerObjective
+}
+
+// QuestRequirement a requirement of a quest, the reset period is one of them
+type QuestRequirement struct {
+	Type string `json:"type"`
+}
+
+// Quest definition from GetQuests
+type Quest struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	Description  string             `json:"description"`
+	Rewards      []QuestReward      `json:"rewards"`
+	Objectives   []QuestObjective   `json:"objectives"`
+	Requirements []QuestRequirement `json:"requirements"`
+}
+
+// QuestPeriod how often a quest resets
+type QuestPeriod uint8
+
+const (
+	QuestOnce QuestPeriod = iota
+	QuestDaily
+	QuestWeekly
+)
+
+// String impl fmt.Stringer
+func (p QuestPeriod) String() string {
+	switch p {
+	case QuestOnce:
+		return "once"
+	case QuestDaily:
+		return "daily"
+	case QuestWeekly:
+		return "weekly"
+	}
+	return "unknown"
+}
+
+// Period reset period read from the requirements
+func (q Quest) Period() QuestPeriod {
+	for _, r := range q.Requirements {
+		switch r.Type {
+		case "DailyResetQuestRequirement":
+			return QuestDaily
+		case "WeeklyResetQuestRequirement":
+			return QuestWeekly
+		}
+	}
+	return QuestOnce
+}
+
+// Quests GetQuests keyed by game ("bedwars", "skywars"...)
+type Quests map[string][]Quest
+
+// ParseQuests decode a GetQuests response
+func ParseQuests(resp Response) (Quests, error) {
+	var body struct {
+		Quests Quests `json:"quests"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	return body.Quests, nil
+}
+
+// Challenge definition from GetChallenges
+type Challenge struct {
+	ID      string        `json:"id"`
+	Name    string        `json:"name"`
+	Rewards []QuestReward `json:"rewards"`
+}
+
+// Challenges GetChallenges keyed by game
+type Challenges map[string][]Challenge
+
+// ParseChallenges decode a GetChallenges response
+func ParseChallenges(resp Response) (Challenges, error) {
+	var body struct {
+		Challenges Challenges `json:"challenges"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	return body.Challenges, nil
+}
+
+// PlayerQuest quest progress stored in player data
+type PlayerQuest struct {
+	Active *struct {
+		Started    int64          `json:"started"` // unix millis
+		Objectives map[string]any `json:"objectives"`
+	} `json:"active"`
+	Completions []struct {
+		Time int64 `json:"time"` // unix millis
+	} `json:"completions"`
+}
+
+// PlayerChallenges challenge completions stored in player data
+type PlayerChallenges struct {
+	AllTime map[string]int64 `json:"all_time"` // "BEDWARS__offensive" -> completions
+}
+
+// QuestState where a quest stands for the current reset period
+type QuestState uint8
+
+const (
+	QuestAvailable QuestState = iota // never started, or completed before the last reset
+	QuestActive
+	QuestCompleted // completed in the current period, see QuestProgress.NextReset
+)
+
+// String impl fmt.Stringer
+func (s QuestState) String() string {
+	switch s {
+	case QuestAvailable:
+		return "available"
+	case QuestActive:
+		return "active"
+	case QuestCompleted:
+		return "completed"
+	}
+	return "unknown"
+}
+
+// QuestProgress a quest definition joined with the player's progress
+type QuestProgress struct {
+	Game          string
+	Quest         Quest
+	Period        QuestPeriod
+	State         QuestState
+	Started       time.Time      // zero unless active
+	Objectives    map[string]any // progress of an active quest
+	Completions   int
+	LastCompleted time.Time
+	NextReset     time.Time // zero for QuestOnce
+}
+
+// ChallengeProgress a challenge joined with the player's completions
+type ChallengeProgress struct {
+	Challenge   Challenge
+	Completions int64
+}
+
+// QuestTracker joins quest and challenge definitions with player data
+type QuestTracker struct {
+	Quests     Quests
+	Challenges Challenges
+	Location   *time.Location // reset time zone, Hypixel resets at midnight US Eastern
+	WeeklyDay  time.Weekday   // weekly reset day
+}
+
+// NewQuestTracker tracker using Hypixel's reset schedule
+func NewQuestTracker(quests Quests, challenges Challenges) *QuestTracker {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		// no tz database, EST without daylight saving
+		loc = time.FixedZone("EST", -5*60*60)
+	}
+	return &QuestTracker{Quests: quests, Challenges: challenges, Location: loc, WeeklyDay: time.Friday}
+}
+
+// LastReset start of the period containing now
+func (t *QuestTracker) LastReset(period QuestPeriod, now time.Time) time.Time {
+	n := now.In(t.Location)
+	day := time.Date(n.Year(), n.Month(), n.Day(), 0, 0, 0, 0, t.Location)
+	switch period {
+	case QuestDaily:
+		return day
+	case QuestWeekly:
+		back := (int(n.Weekday()) - int(t.WeeklyDay) + 7) % 7
+		return day.AddDate(0, 0, -back)
+	case QuestOnce:
+	}
+	return time.Time{}
+}
+
+// NextReset end of the period containing now, zero for QuestOnce
+func (t *QuestTracker) NextReset(period QuestPeriod, now time.Time) time.Time {
+	switch period {
+	case QuestDaily:
+		return t.LastReset(period, now).AddDate(0, 0, 1)
+	case QuestWeekly:
+		return t.LastReset(period, now).AddDate(0, 0, 7)
+	case QuestOnce:
+	}
+	return time.Time{}
+}
+
+// QuestProgress state of every quest for p at now, sorted by game then quest id
+func (t *QuestTracker) QuestProgress(p *Player, now time.Time) []QuestProgress {
+	var out []QuestProgress
+	for game, quests := range t.Quests {
+		for _, q := range quests {
+			pq := p.Quests[q.ID]
+			qp := QuestProgress{
+				Game:        game,
+				Quest:       q,
+				Period:      q.Period(),
+				Completions: len(pq.Completions),
+				NextReset:   t.NextReset(q.Period(), now),
+			}
+			for _, c := range pq.Completions {
+				if ts := time.UnixMilli(c.Time); ts.After(qp.LastCompleted) {
+					qp.LastCompleted = ts
+				}
+			}
+			switch {
+			case pq.Active != nil:
+				qp.State = QuestActive
+				qp.Started = time.UnixMilli(pq.Active.Started)
+				qp.Objectives = pq.Active.Objectives
+			case qp.Completions == 0:
+				qp.State = QuestAvailable
+			case qp.Period == QuestOnce, !qp.LastCompleted.Before(t.LastReset(qp.Period, now)):
+				qp.State = QuestCompleted
+			default:
+				qp.State = QuestAvailable
+			}
+			out = append(out, qp)
+		}
+	}
+	slices.SortFunc(out, func(a, b QuestProgress) int {
+		return cmp.Or(cmp.Compare(a.Game, b.Game), cmp.Compare(a.Quest.ID, b.Quest.ID))
+	})
+	return out
+}
+
+// ChallengeProgress all time completions of every challenge, keyed by game
+func (t *QuestTracker) ChallengeProgress(p *Player) map[string][]ChallengeProgress {
+	out := map[string][]ChallengeProgress{}
+	for game, challenges := range t.Challenges {
+		for _, c := range challenges {
+			key := c.ID
+			if !strings.Contains(key, "__") {
+				key = strings.ToUpper(game) + "__" + key
+			}
+			out[game] = append(out[game], ChallengeProgress{Challenge: c, Completions: p.Challenges.AllTime[key]})
+		}
+	}
+	return out
+}
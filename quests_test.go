@@ -0,0 +1,70 @@
+package hypixel
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestQuestTracker(t *testing.T) {
+	quests, err := ParseQuests(Response{Content: []byte(`{"success":true,"quests":{"bedwars":[
+		{"id":"bw_daily_win","name":"Daily Win","requirements":[{"type":"DailyResetQuestRequirement"}]},
+		{"id":"bw_daily_kills","name":"Daily Kills","requirements":[{"type":"DailyResetQuestRequirement"}]},
+		{"id":"bw_weekly","name":"Weekly","requirements":[{"type":"WeeklyResetQuestRequirement"}]},
+		{"id":"bw_active","name":"Active","requirements":[{"type":"DailyResetQuestRequirement"}]},
+		{"id":"bw_new","name":"New"}
+	]}}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	challenges, _ := ParseChallenges(Response{Content: []byte(`{"success":true,"challenges":{"bedwars":[{"id":"BEDWARS__offensive","name":"Offensive"},{"id":"support","name":"Support"}]}}`)})
+
+	ms := func(s string) int64 {
+		ts, _ := time.Parse(time.RFC3339, s)
+		return ts.UnixMilli()
+	}
+	p, _ := ParsePlayer(Response{Content: []byte(`{"success":true,"player":{
+		"quests":{
+			"bw_daily_win":{"completions":[{"time":` + strconv.FormatInt(ms("2025-05-20T10:00:00Z"), 10) + `},{"time":` + strconv.FormatInt(ms("2025-05-21T01:00:00Z"), 10) + `}]},
+			"bw_daily_kills":{"completions":[{"time":` + strconv.FormatInt(ms("2025-05-20T23:00:00Z"), 10) + `}]},
+			"bw_weekly":{"completions":[{"time":` + strconv.FormatInt(ms("2025-05-17T10:00:00Z"), 10) + `}]},
+			"bw_active":{"active":{"started":` + strconv.FormatInt(ms("2025-05-21T09:00:00Z"), 10) + `,"objectives":{"kills":3}}}
+		},
+		"challenges":{"all_time":{"BEDWARS__offensive":4,"BEDWARS__support":1}}}}`)})
+
+	tr := NewQuestTracker(quests, challenges)
+	tr.Location = time.UTC
+	now := time.Date(2025, 5, 21, 12, 0, 0, 0, time.UTC) // a Wednesday
+
+	want := map[string]QuestState{
+		"bw_daily_win":   QuestCompleted,
+		"bw_daily_kills": QuestAvailable,
+		"bw_weekly":      QuestCompleted,
+		"bw_active":      QuestActive,
+		"bw_new":         QuestAvailable,
+	}
+	for _, qp := range tr.QuestProgress(p, now) {
+		if qp.State != want[qp.Quest.ID] {
+			t.Errorf("%s state = %s; want %s", qp.Quest.ID, qp.State, want[qp.Quest.ID])
+		}
+		switch qp.Quest.ID {
+		case "bw_daily_win":
+			if qp.Completions != 2 || !qp.NextReset.Equal(time.Date(2025, 5, 22, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("daily = %+v", qp)
+			}
+		case "bw_weekly":
+			if qp.Period != QuestWeekly || !qp.NextReset.Equal(time.Date(2025, 5, 23, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("weekly next reset = %v", qp.NextReset)
+			}
+		case "bw_active":
+			if qp.Objectives["kills"] != 3.0 {
+				t.Errorf("active objectives = %v", qp.Objectives)
+			}
+		}
+	}
+
+	cp := tr.ChallengeProgress(p)["bedwars"]
+	if len(cp) != 2 || cp[0].Completions != 4 || cp[1].Completions != 1 {
+		t.Errorf("challenges = %+v", cp)
+	}
+}
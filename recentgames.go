@@ -0,0 +1,95 @@
+package hypixel
+
+import (
+	"slices"
+	"time"
+)
+
+// RecentGame one entry of GetRecentGames
+type RecentGame struct {
+	Date     int64  `json:"date"` // unix millis
+	GameType string `json:"gameType"`
+	Mode     string `json:"mode"`
+	Map      string `json:"map"`
+	Ended    int64  `json:"ended"` // unix millis, 0 while in progress
+}
+
+// ParseRecentGames decode a GetRecentGames response, newest first like the API
+func ParseRecentGames(resp Response) ([]RecentGame, error) {
+	var body struct {
+		Games []RecentGame `json:"games"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	return body.Games, nil
+}
+
+// ResolvedGame a RecentGame joined with Games
+type ResolvedGame struct {
+	RecentGame
+	GameName   string
+	ModeName   string
+	Start      time.Time
+	End        time.Time // zero while in progress
+	Duration   time.Duration
+	InProgress bool
+}
+
+// ResolveRecentGames join games with info, order is kept
+func ResolveRecentGames(games []RecentGame, info Games) []ResolvedGame {
+	out := make([]ResolvedGame, 0, len(games))
+	for _, g := range games {
+		r := ResolvedGame{
+			RecentGame: g,
+			GameName:   info.Name(g.GameType),
+			ModeName:   info.ModeName(g.GameType, g.Mode),
+			Start:      time.UnixMilli(g.Date),
+			InProgress: g.Ended == 0,
+		}
+		if !r.InProgress {
+			r.End = time.UnixMilli(g.Ended)
+			r.Duration = r.End.Sub(r.Start)
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// GameSession consecutive games with no more than the grouping gap between them
+type GameSession struct {
+	Games []ResolvedGame // oldest first
+	Start time.Time
+	End   time.Time // end of the last game, its start while in progress
+}
+
+// Duration time from the first game's start to the last game's end
+func (s GameSession) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+// GroupSessions group games into sessions, oldest first
+// A new session starts when a game starts more than gap after the previous one ended
+func GroupSessions(games []ResolvedGame, gap time.Duration) []GameSession {
+	sorted := slices.Clone(games)
+	slices.SortFunc(sorted, func(a, b ResolvedGame) int {
+		return a.Start.Compare(b.Start)
+	})
+	var sessions []GameSession
+	for _, g := range sorted {
+		end := g.End
+		if g.InProgress {
+			end = g.Start
+		}
+		if n := len(sessions); n > 0 && g.Start.Sub(sessions[n-1].End) <= gap {
+			s := &sessions[n-1]
+			s.Games = append(s.Games, g)
+			if end.After(s.End) {
+				s.End = end
+			}
+			continue
+		}
+		sessions = append(sessions, GameSession{Games: []ResolvedGame{g}, Start: g.Start, End: end})
+	}
+	return sessions
+}
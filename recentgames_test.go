@@ -0,0 +1,53 @@
+package hypixel
+
+import (
+	"testing"
+	"time"
+)
+
+const testGames = `{"success":true,"games":{
+	"BEDWARS":{"id":58,"name":"Bed Wars","databaseName":"Bedwars","modeNames":{"EIGHT_ONE":"Solo"}},
+	"DUELS":{"id":61,"name":"Duels","modeNames":{"DUELS_SUMO_DUEL":"Sumo Duel"}}}}`
+
+func TestParseGames(t *testing.T) {
+	g, err := ParseGames(Response{Content: []byte(testGames)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.Name("BEDWARS") != "Bed Wars" || g.ModeName("BEDWARS", "EIGHT_ONE") != "Solo" {
+		t.Errorf("got %v", g)
+	}
+	if g.Name("PIT") != "PIT" || g.ModeName("BEDWARS", "FOUR_FOUR") != "FOUR_FOUR" {
+		t.Error("unknown names should fall back to the code")
+	}
+}
+
+func TestRecentGames(t *testing.T) {
+	info, _ := ParseGames(Response{Content: []byte(testGames)})
+	recent, err := ParseRecentGames(Response{Content: []byte(`{"success":true,"games":[
+		{"date":7200000,"gameType":"DUELS","mode":"DUELS_SUMO_DUEL","map":"Ocean"},
+		{"date":1000000,"gameType":"BEDWARS","mode":"EIGHT_ONE","map":"Lighthouse","ended":1600000},
+		{"date":0,"gameType":"BEDWARS","mode":"EIGHT_ONE","map":"Aquarium","ended":900000}
+	]}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	games := ResolveRecentGames(recent, info)
+	if games[1].GameName != "Bed Wars" || games[1].ModeName != "Solo" || games[1].Duration != 10*time.Minute {
+		t.Errorf("resolved = %+v", games[1])
+	}
+	if !games[0].InProgress || games[0].Duration != 0 {
+		t.Errorf("in progress = %+v", games[0])
+	}
+
+	sessions := GroupSessions(games, 5*time.Minute)
+	if len(sessions) != 2 {
+		t.Fatalf("got %d sessions; want 2", len(sessions))
+	}
+	if len(sessions[0].Games) != 2 || sessions[0].Games[0].Map != "Aquarium" || sessions[0].Duration() != 1600*time.Second {
+		t.Errorf("first session = %+v", sessions[0])
+	}
+	if len(sessions[1].Games) != 1 || sessions[1].Games[0].Map != "Ocean" {
+		t.Errorf("second session = %+v", sessions[1])
+	}
+}
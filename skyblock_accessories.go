@@ -0,0 +1,168 @@
+package hypixel
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// AccessoryFamilies upgrade chains from lowest to highest, only the highest owned member of a family counts
+var AccessoryFamilies = map[string][]string{
+	"SPEED":              {"SPEED_TALISMAN", "SPEED_RING", "SPEED_ARTIFACT"},
+	"FEATHER":            {"FEATHER_TALISMAN", "FEATHER_RING", "FEATHER_ARTIFACT"},
+	"POTION_AFFINITY":    {"POTION_AFFINITY_TALISMAN", "RING_POTION_AFFINITY", "ARTIFACT_POTION_AFFINITY"},
+	"SEA_CREATURE":       {"SEA_CREATURE_TALISMAN", "SEA_CREATURE_RING", "SEA_CREATURE_ARTIFACT"},
+	"HEALING":            {"HEALING_TALISMAN", "HEALING_RING"},
+	"CANDY":              {"CANDY_TALISMAN", "CANDY_RING", "CANDY_ARTIFACT", "CANDY_RELIC"},
+	"INTIMIDATION":       {"INTIMIDATION_TALISMAN", "INTIMIDATION_RING", "INTIMIDATION_ARTIFACT", "INTIMIDATION_RELIC"},
+	"SPIDER":             {"SPIDER_TALISMAN", "SPIDER_RING", "SPIDER_ARTIFACT"},
+	"RED_CLAW":           {"RED_CLAW_TALISMAN", "RED_CLAW_RING", "RED_CLAW_ARTIFACT"},
+	"ZOMBIE":             {"ZOMBIE_TALISMAN", "ZOMBIE_RING", "ZOMBIE_ARTIFACT"},
+	"BAT":                {"BAT_TALISMAN", "BAT_RING", "BAT_ARTIFACT"},
+	"HUNTER":             {"HUNTER_TALISMAN", "HUNTER_RING"},
+	"TREASURE":           {"TREASURE_TALISMAN", "TREASURE_RING", "TREASURE_ARTIFACT"},
+	"DRACONIC":           {"DRACONIC_TALISMAN", "DRACONIC_RING", "DRACONIC_ARTIFACT"},
+	"BURSTSTOPPER":       {"BURSTSTOPPER_TALISMAN", "BURSTSTOPPER_ARTIFACT"},
+	"SHADY":              {"SHADY_RING", "CROOKED_ARTIFACT", "SEAL_OF_THE_FAMILY"},
+	"CAT":                {"CAT_TALISMAN", "LYNX_TALISMAN", "CHEETAH_TALISMAN"},
+	"SCARF":              {"SCARF_STUDIES", "SCARF_THESIS", "SCARF_GRIMOIRE"},
+	"WITHER":             {"WITHER_ARTIFACT", "WITHER_RELIC"},
+	"BAIT":               {"BAIT_RING", "SPIKED_ATROCITY"},
+	"SOULFLOW":           {"SOULFLOW_PILE", "SOULFLOW_BATTERY", "SOULFLOW_SUPERCELL"},
+	"BEASTMASTER":        {"BEASTMASTER_CREST_COMMON", "BEASTMASTER_CREST_UNCOMMON", "BEASTMASTER_CREST_RARE", "BEASTMASTER_CREST_EPIC", "BEASTMASTER_CREST_LEGENDARY"},
+	"MASTER_SKULL":       {"MASTER_SKULL_TIER_1", "MASTER_SKULL_TIER_2", "MASTER_SKULL_TIER_3", "MASTER_SKULL_TIER_4", "MASTER_SKULL_TIER_5", "MASTER_SKULL_TIER_6", "MASTER_SKULL_TIER_7"},
+	"PARTY_HAT":          {"PARTY_HAT_CRAB", "PARTY_HAT_CRAB_ANIMATED", "PARTY_HAT_SLOTH"},
+	"PERSONAL_COMPACTOR": {"PERSONAL_COMPACTOR_4000", "PERSONAL_COMPACTOR_5000", "PERSONAL_COMPACTOR_6000", "PERSONAL_COMPACTOR_7000"},
+}
+
+// Special accessories and bonuses
+const (
+	accessoryHegemony = "HEGEMONY_ARTIFACT" // counts its rarity's magical power twice
+	accessoryAbicase  = "ABICASE_"          // prefix, one magical power per two Abiphone contacts
+	riftPrismPower    = 11                  // consumed in the Rift, counts without being in the bag
+)
+
+// RarityMagicalPower magical power of one accessory of rarity r
+func RarityMagicalPower(r Rarity) int {
+	switch r {
+	case RarityCommon, RaritySpecial:
+		return 3
+	case RarityUncommon, RarityVerySpecial:
+		return 5
+	case RarityRare:
+		return 8
+	case RarityEpic:
+		return 12
+	case RarityLegendary:
+		return 16
+	case RarityMythic:
+		return 22
+	case RarityUnknown, RarityDivine, RarityUltimate, RarityAdmin:
+	}
+	return 0
+}
+
+// Accessory an accessory from the bag
+type Accessory struct {
+	Item
+	Rarity       Rarity // recombobulation included
+	Family       string // key in AccessoryFamilies, the SkyBlock id otherwise
+	MagicalPower int    // 0 for duplicates
+}
+
+// AccessoryPower magical power of a member's accessory bag
+type AccessoryPower struct {
+	Accessories         []Accessory    // counted, sorted by SkyBlock id
+	Duplicates          []Accessory    // lower tiers of a family or repeated ids, not counted
+	Rarities            map[Rarity]int // counted accessories per rarity
+	Recombobulated      int
+	AbiphoneContacts    int
+	RiftPrism           bool
+	MagicalPower        int    // total, bonuses included
+	SelectedPower       string // power stone, e.g. "silky"
+	HighestMagicalPower int    // as stored by the game
+}
+
+// accessoryFamily family key and position in the chain of id
+func accessoryFamily(id string) (string, int) {
+	for family, chain := range AccessoryFamilies {
+		if i := slices.Index(chain, id); i >= 0 {
+			return family, i
+		}
+	}
+	return id, 0
+}
+
+// MagicalPower decode the accessory bag and compute its magical power
+// ErrInventoryDisabled if the member disabled the inventory API
+func (m *ProfileMember) MagicalPower() (*AccessoryPower, error) {
+	if m.Inventory == nil {
+		return nil, ErrInventoryDisabled
+	}
+	var items []Item
+	if bag, ok := m.Inventory.BagContents[string(ContainerAccessoryBag)]; ok && bag.Data != "" {
+		var err error
+		if items, err = DecodeItems(bag.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &AccessoryPower{
+		Rarities:         map[Rarity]int{},
+		AbiphoneContacts: len(m.NetherIsland.Abiphone.ActiveContacts),
+		RiftPrism:        m.Rift.Access.ConsumedPrism,
+	}
+	if s := m.AccessoryBagStorage; s != nil {
+		out.SelectedPower = s.SelectedPower
+		out.HighestMagicalPower = s.HighestMagicalPower
+	}
+
+	// keep the highest tier of every family, then the highest rarity
+	best := map[string]int{}
+	rank := map[string]int{}
+	var all []Accessory
+	for _, item := range items {
+		if item.SkyBlockID == "" {
+			continue
+		}
+		family, tier := accessoryFamily(item.SkyBlockID)
+		a := Accessory{Item: item, Rarity: item.Rarity(), Family: family}
+		i := len(all)
+		all = append(all, a)
+		score := tier*100 + int(a.Rarity)
+		if j, ok := best[family]; !ok || score > rank[family] {
+			if ok {
+				out.Duplicates = append(out.Duplicates, all[j])
+			}
+			best[family], rank[family] = i, score
+		} else {
+			out.Duplicates = append(out.Duplicates, a)
+		}
+	}
+	for _, i := range best {
+		a := all[i]
+		a.MagicalPower = RarityMagicalPower(a.Rarity)
+		switch {
+		case a.SkyBlockID == accessoryHegemony:
+			a.MagicalPower *= 2
+		case strings.HasPrefix(a.SkyBlockID, accessoryAbicase):
+			a.MagicalPower += out.AbiphoneContacts / 2
+		}
+		if a.Recombobulated() {
+			out.Recombobulated++
+		}
+		out.Rarities[a.Rarity]++
+		out.MagicalPower += a.MagicalPower
+		out.Accessories = append(out.Accessories, a)
+	}
+	if out.RiftPrism {
+		out.MagicalPower += riftPrismPower
+	}
+
+	bySlot := func(a, b Accessory) int {
+		return cmp.Or(cmp.Compare(a.SkyBlockID, b.SkyBlockID), cmp.Compare(a.Slot, b.Slot))
+	}
+	slices.SortFunc(out.Accessories, bySlot)
+	slices.SortFunc(out.Duplicates, bySlot)
+	return out, nil
+}
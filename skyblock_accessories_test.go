@@ -0,0 +1,74 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestItem_Rarity(t *testing.T) {
+	tests := []struct {
+		lore []string
+		want Rarity
+	}{
+		{[]string{"§7Speed +1", "§f§lCOMMON ACCESSORY"}, RarityCommon},
+		{[]string{"§d§l§ka§r §d§lMYTHIC ACCESSORY §d§l§ka", ""}, RarityMythic},
+		{[]string{"§c§lVERY SPECIAL HATCESSORY"}, RarityVerySpecial},
+		{[]string{"§7no rarity"}, RarityUnknown},
+		{nil, RarityUnknown},
+	}
+	for _, tt := range tests {
+		if got := (Item{Lore: tt.lore}).Rarity(); got != tt.want {
+			t.Errorf("Rarity(%q) = %v, want %v", tt.lore, got, tt.want)
+		}
+	}
+}
+
+func TestProfileMember_MagicalPower(t *testing.T) {
+	recomb := map[string]any{"rarity_upgrades": int32(1)}
+	bag := encodeItems(
+		testItem("SPEED_TALISMAN", "§fSpeed Talisman", nil, "§f§lCOMMON ACCESSORY"),
+		testItem("SPEED_ARTIFACT", "§9Speed Artifact", recomb, "§5§lEPIC ACCESSORY"),
+		testItem("SPEED_RING", "§aSpeed Ring", nil, "§a§lUNCOMMON ACCESSORY"),
+		testItem("HEGEMONY_ARTIFACT", "§6Hegemony Artifact", nil, "§6§lLEGENDARY ARTIFACT"),
+		testItem("ABICASE_SUMSUNG_1", "§9Abicase", nil, "§9§lRARE ACCESSORY"),
+		testItem("FEATHER_TALISMAN", "§fFeather Talisman", nil, "§f§lCOMMON ACCESSORY"),
+		testItem("FEATHER_TALISMAN", "§fFeather Talisman", nil, "§f§lCOMMON ACCESSORY"),
+	)
+	raw, _ := json.Marshal(map[string]any{
+		"inventory":                 map[string]any{"bag_contents": map[string]any{"talisman_bag": map[string]any{"type": 0, "data": bag}}},
+		"accessory_bag_storage":     map[string]any{"selected_power": "silky", "highest_magical_power": 70},
+		"rift":                      map[string]any{"access": map[string]any{"consumed_prism": true}},
+		"nether_island_player_data": map[string]any{"abiphone": map[string]any{"active_contacts": []string{"a", "b", "c", "d", "e"}}},
+	})
+	var m ProfileMember
+	if err := json.Unmarshal(raw, &m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mp, err := m.MagicalPower()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids := make([]string, 0, len(mp.Accessories))
+	for _, a := range mp.Accessories {
+		ids = append(ids, a.SkyBlockID)
+	}
+	if len(ids) != 4 || ids[0] != "ABICASE_SUMSUNG_1" || ids[1] != "FEATHER_TALISMAN" || ids[2] != "HEGEMONY_ARTIFACT" || ids[3] != "SPEED_ARTIFACT" {
+		t.Fatalf("accessories = %v", ids)
+	}
+	if len(mp.Duplicates) != 3 {
+		t.Errorf("duplicates = %d, want 3", len(mp.Duplicates))
+	}
+	// abicase 8+2, feather 3, hegemony 16*2, speed artifact epic 12, rift prism 11
+	if want := 10 + 3 + 32 + 12 + 11; mp.MagicalPower != want {
+		t.Errorf("MagicalPower = %d, want %d", mp.MagicalPower, want)
+	}
+	if mp.Recombobulated != 1 || mp.Rarities[RarityEpic] != 1 || mp.SelectedPower != "silky" || mp.HighestMagicalPower != 70 || !mp.RiftPrism {
+		t.Errorf("power = %+v", mp)
+	}
+
+	if _, err := (&ProfileMember{}).MagicalPower(); !errors.Is(err, ErrInventoryDisabled) {
+		t.Errorf("err = %v, want ErrInventoryDisabled", err)
+	}
+}
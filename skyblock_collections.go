@@ -0,0 +1,114 @@
+package hypixel
+
+import (
+	"cmp"
+	"slices"
+)
+
+// CollectionTier one tier of a collection from GetSkyBlockCollections
+type CollectionTier struct {
+	Tier           int      `json:"tier"`
+	AmountRequired int64    `json:"amountRequired"`
+	Unlocks        []string `json:"unlocks"`
+}
+
+// CollectionItem a collection from GetSkyBlockCollections
+type CollectionItem struct {
+	Name     string           `json:"name"`
+	MaxTiers int              `json:"maxTiers"`
+	Tiers    []CollectionTier `json:"tiers"`
+}
+
+// CollectionCategory a collection category ("FARMING", "MINING"...)
+type CollectionCategory struct {
+	Name  string                    `json:"name"`
+	Items map[string]CollectionItem `json:"items"` // keyed by item id ("WHEAT", "LOG:2"...)
+}
+
+// Collections GetSkyBlockCollections keyed by category id
+type Collections map[string]CollectionCategory
+
+// ParseCollections decode a GetSkyBlockCollections response
+func ParseCollections(resp Response) (Collections, error) {
+	var body struct {
+		Collections Collections `json:"collections"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	return body.Collections, nil
+}
+
+// CollectionProgress a collection joined with the profile's count
+type CollectionProgress struct {
+	ID           string
+	Name         string
+	Amount       int64
+	Tier         int // highest tier reached, 0 if none
+	MaxTier      int
+	NextRequired int64 // amount required for the next tier, 0 when maxed
+	Maxed        bool
+	Unlocks      []string // rewards of every tier reached
+}
+
+// CategoryProgress collections of one category
+type CategoryProgress struct {
+	Name    string
+	Items   []CollectionProgress // sorted by id
+	Maxed   int
+	Unlocks []string // rewards of every tier reached in the category
+}
+
+// ProfileCollections collection counts summed across every member of p
+// Members with the collections API disabled are skipped
+func ProfileCollections(p *Profile) map[string]int64 {
+	out := map[string]int64{}
+	for _, m := range p.Members {
+		for id, n := range m.Collection {
+			out[id] += n
+		}
+	}
+	return out
+}
+
+// Progress tiers reached by p's co-op in every collection, keyed by category id
+func (c Collections) Progress(p *Profile) map[string]CategoryProgress {
+	counts := ProfileCollections(p)
+	out := make(map[string]CategoryProgress, len(c))
+	for id, category := range c {
+		cp := CategoryProgress{Name: category.Name}
+		for itemID, item := range category.Items {
+			ip := item.progress(counts[itemID])
+			ip.ID = itemID
+			cp.Items = append(cp.Items, ip)
+		}
+		slices.SortFunc(cp.Items, func(a, b CollectionProgress) int { return cmp.Compare(a.ID, b.ID) })
+		for _, ip := range cp.Items {
+			if ip.Maxed {
+				cp.Maxed++
+			}
+			cp.Unlocks = append(cp.Unlocks, ip.Unlocks...)
+		}
+		out[id] = cp
+	}
+	return out
+}
+
+func (item CollectionItem) progress(amount int64) CollectionProgress {
+	tiers := slices.Clone(item.Tiers)
+	slices.SortFunc(tiers, func(a, b CollectionTier) int { return a.Tier - b.Tier })
+	p := CollectionProgress{Name: item.Name, Amount: amount, MaxTier: item.MaxTiers}
+	if p.MaxTier == 0 && len(tiers) > 0 {
+		p.MaxTier = tiers[len(tiers)-1].Tier
+	}
+	for _, t := range tiers {
+		if amount < t.AmountRequired {
+			p.NextRequired = t.AmountRequired
+			break
+		}
+		p.Tier = t.Tier
+		p.Unlocks = append(p.Unlocks, t.Unlocks...)
+	}
+	p.Maxed = p.Tier >= p.MaxTier
+	return p
+}
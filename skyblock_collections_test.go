@@ -0,0 +1,40 @@
+package hypixel
+
+import (
+	"slices"
+	"testing"
+)
+
+const testCollections = `{"success":true,"collections":{
+	"FARMING":{"name":"Farming","items":{
+		"WHEAT":{"name":"Wheat","maxTiers":3,"tiers":[{"tier":1,"amountRequired":50,"unlocks":["Wheat Minion"]},{"tier":3,"amountRequired":250,"unlocks":["Farm Suit"]},{"tier":2,"amountRequired":100,"unlocks":["Enchanted Bread"]}]},
+		"CARROT_ITEM":{"name":"Carrot","maxTiers":2,"tiers":[{"tier":1,"amountRequired":100,"unlocks":["Carrot Minion"]},{"tier":2,"amountRequired":250,"unlocks":["Enchanted Carrot"]}]}
+	}}
+}}`
+
+func TestCollections_Progress(t *testing.T) {
+	collections, err := ParseCollections(Response{Content: []byte(testCollections)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := &Profile{Members: map[string]*ProfileMember{
+		"a": {Collection: map[string]int64{"WHEAT": 200, "CARROT_ITEM": 40}},
+		"b": {Collection: map[string]int64{"WHEAT": 60, "CARROT_ITEM": 20}},
+		"c": {}, // collections API disabled
+	}}
+	farming := collections.Progress(p)["FARMING"]
+	if farming.Name != "Farming" || len(farming.Items) != 2 || farming.Maxed != 1 {
+		t.Fatalf("category = %+v", farming)
+	}
+
+	carrot, wheat := farming.Items[0], farming.Items[1]
+	if carrot.ID != "CARROT_ITEM" || carrot.Amount != 60 || carrot.Tier != 0 || carrot.NextRequired != 100 || carrot.Maxed {
+		t.Errorf("carrot = %+v", carrot)
+	}
+	if wheat.Amount != 260 || wheat.Tier != 3 || wheat.NextRequired != 0 || !wheat.Maxed {
+		t.Errorf("wheat = %+v", wheat)
+	}
+	if want := []string{"Wheat Minion", "Enchanted Bread", "Farm Suit"}; !slices.Equal(wheat.Unlocks, want) {
+		t.Errorf("unlocks = %v, want %v", wheat.Unlocks, want)
+	}
+}
@@ -0,0 +1,146 @@
+package hypixel
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// DungeonClasses dungeon class ids
+var DungeonClasses = []string{"healer", "mage", "berserk", "archer", "tank"}
+
+// dungeonLevels experience from level i to i+1, shared by catacombs and classes
+var dungeonLevels = []float64{
+	50, 75, 110, 160, 230, 330, 470, 670, 950, 1340,
+	1890, 2665, 3760, 5260, 7380, 10300, 14400, 20000, 27600, 38000,
+	52500, 71500, 97000, 132000, 180000, 243000, 328000, 445000, 600000, 800000,
+	1065000, 1410000, 1900000, 2500000, 3300000, 4300000, 5600000, 7200000, 9200000, 12000000,
+	15000000, 19000000, 24000000, 30000000, 38000000, 48000000, 60000000, 75000000, 93000000, 116250000,
+}
+
+// dungeonOverflowLevel experience per level past 50
+const dungeonOverflowLevel = 200_000_000
+
+var dungeonTotal = cumulative(dungeonLevels)
+
+// cumulative running total of per level experience, starting at 0
+func cumulative(levels []float64) []float64 {
+	total := make([]float64, len(levels)+1)
+	for i, xp := range levels {
+		total[i+1] = total[i] + xp
+	}
+	return total
+}
+
+// DungeonLevel catacombs or class level for exp, capped at 50 with the rest in Overflow
+func DungeonLevel(exp float64) LevelProgress {
+	return tableLevel(dungeonTotal, exp, 0)
+}
+
+// DungeonOverflowLevel exact level without the cap at 50, every level past it costs 200M
+func DungeonOverflowLevel(exp float64) float64 {
+	l := DungeonLevel(exp)
+	if !l.Maxed {
+		return l.Exact
+	}
+	return l.Exact + l.Overflow/dungeonOverflowLevel
+}
+
+// DungeonFloor runs of one floor
+type DungeonFloor struct {
+	Floor            int
+	Master           bool
+	TimesPlayed      int64
+	Completions      int64
+	FastestTime      time.Duration // 0 if never completed
+	FastestTimeS     time.Duration
+	FastestTimeSPlus time.Duration
+	BestScore        int
+	MobsKilled       int64
+	WatcherKills     int64
+}
+
+// Name "Entrance", "Floor 7", "Master Floor 7"
+func (f DungeonFloor) Name() string {
+	switch {
+	case f.Master:
+		return "Master Floor " + strconv.Itoa(f.Floor)
+	case f.Floor == 0:
+		return "Entrance"
+	}
+	return "Floor " + strconv.Itoa(f.Floor)
+}
+
+// DungeonProgress typed view of a member's dungeon data
+type DungeonProgress struct {
+	Catacombs         LevelProgress
+	CatacombsOverflow float64                  // exact level past 50, see DungeonOverflowLevel
+	Classes           map[string]LevelProgress // keyed by DungeonClasses
+	ClassAverage      float64                  // integer levels
+	SelectedClass     string
+	Secrets           int64
+	Floors            []DungeonFloor // catacombs, sorted by floor
+	MasterFloors      []DungeonFloor // master mode, sorted by floor
+}
+
+// DungeonProgress false if the member has no dungeon data
+func (m *ProfileMember) DungeonProgress() (DungeonProgress, bool) {
+	d := m.Dungeons
+	if d == nil {
+		return DungeonProgress{}, false
+	}
+	cata := d.DungeonTypes["catacombs"]
+	out := DungeonProgress{
+		Catacombs:         DungeonLevel(cata.Experience),
+		CatacombsOverflow: DungeonOverflowLevel(cata.Experience),
+		Classes:           make(map[string]LevelProgress, len(DungeonClasses)),
+		SelectedClass:     d.SelectedDungeonClass,
+		Secrets:           d.Secrets,
+		Floors:            dungeonFloors(cata, false),
+		MasterFloors:      dungeonFloors(d.DungeonTypes["master_catacombs"], true),
+	}
+	var sum int
+	for _, class := range DungeonClasses {
+		l := DungeonLevel(d.PlayerClasses[class].Experience)
+		out.Classes[class] = l
+		sum += l.Level
+	}
+	out.ClassAverage = float64(sum) / float64(len(DungeonClasses))
+	return out, true
+}
+
+// dungeonFloors every floor present in any of t's floor maps, "total" and other non numeric keys are skipped
+func dungeonFloors(t DungeonType, master bool) []DungeonFloor {
+	floors := map[int]*DungeonFloor{}
+	set := func(m map[string]float64, fn func(f *DungeonFloor, v float64)) {
+		for k, v := range m {
+			n, err := strconv.Atoi(k)
+			if err != nil {
+				continue
+			}
+			f, ok := floors[n]
+			if !ok {
+				f = &DungeonFloor{Floor: n, Master: master}
+				floors[n] = f
+			}
+			fn(f, v)
+		}
+	}
+	millis := func(v float64) time.Duration { return time.Duration(v) * time.Millisecond }
+	set(t.TimesPlayed, func(f *DungeonFloor, v float64) { f.TimesPlayed = int64(v) })
+	set(t.TierCompletions, func(f *DungeonFloor, v float64) { f.Completions = int64(v) })
+	set(t.FastestTime, func(f *DungeonFloor, v float64) { f.FastestTime = millis(v) })
+	set(t.FastestTimeS, func(f *DungeonFloor, v float64) { f.FastestTimeS = millis(v) })
+	set(t.FastestTimeSPlus, func(f *DungeonFloor, v float64) { f.FastestTimeSPlus = millis(v) })
+	set(t.BestScore, func(f *DungeonFloor, v float64) { f.BestScore = int(v) })
+	set(t.MobsKilled, func(f *DungeonFloor, v float64) { f.MobsKilled = int64(v) })
+	set(t.WatcherKills, func(f *DungeonFloor, v float64) { f.WatcherKills = int64(v) })
+
+	out := make([]DungeonFloor, 0, len(floors))
+	for _, f := range floors {
+		out = append(out, *f)
+	}
+	slices.SortFunc(out, func(a, b DungeonFloor) int { return cmp.Compare(a.Floor, b.Floor) })
+	return out
+}
@@ -0,0 +1,69 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDungeonLevel(t *testing.T) {
+	if got := dungeonTotal[len(dungeonTotal)-1]; got != 569_809_640 {
+		t.Fatalf("total to level 50 = %v", got)
+	}
+	tests := []struct {
+		exp      float64
+		level    int
+		overflow float64
+		exact    float64
+	}{
+		{0, 0, 0, 0},
+		{25, 0, 0, 0.5},
+		{125, 2, 0, 2},
+		{569_809_640, 50, 0, 50},
+		{969_809_640, 50, 400_000_000, 52},
+	}
+	for _, tt := range tests {
+		got := DungeonLevel(tt.exp)
+		if got.Level != tt.level || got.Overflow != tt.overflow {
+			t.Errorf("DungeonLevel(%v) = %+v", tt.exp, got)
+		}
+		if o := DungeonOverflowLevel(tt.exp); o != tt.exact {
+			t.Errorf("DungeonOverflowLevel(%v) = %v, want %v", tt.exp, o, tt.exact)
+		}
+	}
+}
+
+func TestProfileMember_DungeonProgress(t *testing.T) {
+	var m ProfileMember
+	err := json.Unmarshal([]byte(`{"dungeons":{
+		"dungeon_types":{
+			"catacombs":{"experience":125,"times_played":{"0":3,"7":2},"tier_completions":{"0":3,"7":1,"total":4},"fastest_time":{"7":300000},"best_score":{"7":305}},
+			"master_catacombs":{"tier_completions":{"1":5}}
+		},
+		"player_classes":{"healer":{"experience":125},"mage":{"experience":335}},
+		"selected_dungeon_class":"mage","secrets":120
+	}}`), &m)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	d, ok := m.DungeonProgress()
+	if !ok {
+		t.Fatal("expected dungeon data")
+	}
+	if d.Catacombs.Level != 2 || d.Classes["mage"].Level != 3 || d.ClassAverage != 1 || d.SelectedClass != "mage" || d.Secrets != 120 {
+		t.Errorf("progress = %+v", d)
+	}
+	if len(d.Floors) != 2 || d.Floors[0].Name() != "Entrance" || d.Floors[1].Name() != "Floor 7" {
+		t.Fatalf("floors = %+v", d.Floors)
+	}
+	if f := d.Floors[1]; f.TimesPlayed != 2 || f.Completions != 1 || f.FastestTime != 5*time.Minute || f.BestScore != 305 {
+		t.Errorf("floor 7 = %+v", f)
+	}
+	if len(d.MasterFloors) != 1 || d.MasterFloors[0].Name() != "Master Floor 1" || d.MasterFloors[0].Completions != 5 {
+		t.Errorf("master floors = %+v", d.MasterFloors)
+	}
+
+	if _, ok := (&ProfileMember{}).DungeonProgress(); ok {
+		t.Error("expected no dungeon data")
+	}
+}
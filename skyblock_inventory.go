@@ -0,0 +1,218 @@
+package hypixel
+
+import (
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInventoryDisabled the member disabled the inventory API
+var ErrInventoryDisabled = errors.New("hypixel: inventory API disabled")
+
+// ContainerKind where items are stored, bags use their API key
+type ContainerKind string
+
+const (
+	ContainerInventory     ContainerKind = "inventory"
+	ContainerArmor         ContainerKind = "armor"
+	ContainerEquipment     ContainerKind = "equipment"
+	ContainerEnderChest    ContainerKind = "ender_chest"
+	ContainerWardrobe      ContainerKind = "wardrobe"
+	ContainerPersonalVault ContainerKind = "personal_vault"
+	ContainerBackpack      ContainerKind = "backpack" // one container per page
+	ContainerAccessoryBag  ContainerKind = "talisman_bag"
+	ContainerPotionBag     ContainerKind = "potion_bag"
+	ContainerFishingBag    ContainerKind = "fishing_bag"
+	ContainerQuiver        ContainerKind = "quiver"
+	ContainerSacksBag      ContainerKind = "sacks_bag"
+)
+
+// Item one decoded item stack
+type Item struct {
+	Slot       int    // index in the container, empty slots are not returned
+	ItemID     int16  // legacy Minecraft item id
+	Count      int    // stack size
+	Damage     int16  // legacy Minecraft damage value
+	Name       string // display name with colour codes
+	Lore       []string
+	SkyBlockID string         // ExtraAttributes.id, e.g. "HYPERION"
+	UUID       string         // ExtraAttributes.uuid, empty for stackable items
+	Attributes map[string]any // ExtraAttributes as decoded by ReadNBT
+	Tag        map[string]any // the whole tag compound
+}
+
+// Container decoded items of one container
+type Container struct {
+	Kind  ContainerKind
+	Page  int // backpack slot, 0 for other containers
+	Items []Item
+}
+
+// Inventory every decoded container of a member
+type Inventory struct {
+	Containers []Container      // fixed containers first, then backpacks by page, then bags by key
+	Sacks      map[string]int64 // sack item id -> count, not NBT encoded
+}
+
+// Container items of the first container of kind, nil if absent
+func (inv *Inventory) Container(kind ContainerKind) []Item {
+	for _, c := range inv.Containers {
+		if c.Kind == kind {
+			return c.Items
+		}
+	}
+	return nil
+}
+
+// DecodeInventory decode every container of the member
+// ErrInventoryDisabled if the member disabled the inventory API
+func (m *ProfileMember) DecodeInventory() (*Inventory, error) {
+	inv := m.Inventory
+	if inv == nil {
+		return nil, ErrInventoryDisabled
+	}
+	out := &Inventory{Sacks: inv.SacksCounts}
+	add := func(kind ContainerKind, page int, e *EncodedItems) error {
+		if e == nil || e.Data == "" {
+			return nil
+		}
+		items, err := DecodeItems(e.Data)
+		if err != nil {
+			return fmt.Errorf("hypixel: decode %s: %w", kind, err)
+		}
+		out.Containers = append(out.Containers, Container{Kind: kind, Page: page, Items: items})
+		return nil
+	}
+
+	fixed := []struct {
+		kind ContainerKind
+		data *EncodedItems
+	}{
+		{ContainerInventory, inv.InvContents},
+		{ContainerArmor, inv.InvArmor},
+		{ContainerEquipment, inv.EquipmentContents},
+		{ContainerEnderChest, inv.EnderChestContents},
+		{ContainerWardrobe, inv.WardrobeContents},
+		{ContainerPersonalVault, inv.PersonalVaultContents},
+	}
+	for _, f := range fixed {
+		if err := add(f.kind, 0, f.data); err != nil {
+			return nil, err
+		}
+	}
+
+	pages := make([]int, 0, len(inv.BackpackContents))
+	for k := range inv.BackpackContents {
+		if page, err := strconv.Atoi(k); err == nil {
+			pages = append(pages, page)
+		}
+	}
+	slices.Sort(pages)
+	for _, page := range pages {
+		e := inv.BackpackContents[strconv.Itoa(page)]
+		if err := add(ContainerBackpack, page, &e); err != nil {
+			return nil, err
+		}
+	}
+
+	bags := make([]string, 0, len(inv.BagContents))
+	for k := range inv.BagContents {
+		bags = append(bags, k)
+	}
+	slices.SortFunc(bags, cmp.Compare)
+	for _, bag := range bags {
+		e := inv.BagContents[bag]
+		if err := add(ContainerKind(bag), 0, &e); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// DecodeItems decode a base64, gzipped NBT item list as found in EncodedItems.Data
+func DecodeItems(data string) ([]Item, error) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	_, root, err := ReadNBT(zr)
+	if err != nil {
+		return nil, err
+	}
+	compound, _ := root.(map[string]any)
+	list, _ := compound["i"].([]any)
+
+	var items []Item
+	for slot, v := range list {
+		c, _ := v.(map[string]any)
+		if len(c) == 0 {
+			continue
+		}
+		items = append(items, nbtItem(slot, c))
+	}
+	return items, nil
+}
+
+func nbtItem(slot int, c map[string]any) Item {
+	item := Item{Slot: slot}
+	if id, ok := nbtNumber(c["id"]); ok {
+		item.ItemID = int16(id)
+	}
+	if n, ok := nbtNumber(c["Count"]); ok {
+		item.Count = int(n)
+	}
+	if n, ok := nbtNumber(c["Damage"]); ok {
+		item.Damage = int16(n)
+	}
+	item.Tag, _ = c["tag"].(map[string]any)
+	if display, ok := item.Tag["display"].(map[string]any); ok {
+		item.Name, _ = display["Name"].(string)
+		lore, _ := display["Lore"].([]any)
+		for _, l := range lore {
+			if s, ok := l.(string); ok {
+				item.Lore = append(item.Lore, s)
+			}
+		}
+	}
+	item.Attributes, _ = item.Tag["ExtraAttributes"].(map[string]any)
+	item.SkyBlockID, _ = item.Attributes["id"].(string)
+	item.UUID, _ = item.Attributes["uuid"].(string)
+	return item
+}
+
+// Rarity rarity shown on the last lore line, recombobulation included
+func (i Item) Rarity() Rarity {
+	for j := len(i.Lore) - 1; j >= 0; j-- {
+		words := strings.Fields(StripColor(i.Lore[j]))
+		if len(words) == 0 {
+			continue
+		}
+		for k, w := range words {
+			if k+1 < len(words) && w == "VERY" && words[k+1] == "SPECIAL" {
+				return RarityVerySpecial
+			}
+			if r := ParseRarity(w); r != RarityUnknown {
+				return r
+			}
+		}
+		return RarityUnknown
+	}
+	return RarityUnknown
+}
+
+// Recombobulated the rarity was upgraded by a Recombobulator 3000
+func (i Item) Recombobulated() bool {
+	n, _ := nbtNumber(i.Attributes["rarity_upgrades"])
+	return n > 0
+}
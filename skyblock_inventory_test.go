@@ -0,0 +1,59 @@
+package hypixel
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProfileMember_DecodeInventory(t *testing.T) {
+	m := &ProfileMember{Inventory: &MemberInventory{
+		InvContents: &EncodedItems{Data: encodeItems(
+			testItem("HYPERION", "§dHeroic Hyperion", map[string]any{"uuid": "abc"}, "§d§lMYTHIC DUNGEON SWORD"),
+			nil,
+			testItem("ENCHANTED_DIAMOND", "§aEnchanted Diamond", nil),
+		)},
+		BackpackContents: map[string]EncodedItems{
+			"10": {Data: encodeItems(nil, testItem("ASPECT_OF_THE_END", "§9Aspect of the End", nil))},
+			"2":  {Data: encodeItems()},
+		},
+		BagContents: map[string]EncodedItems{
+			"talisman_bag": {Data: encodeItems(testItem("SPEED_TALISMAN", "§fSpeed Talisman", nil))},
+		},
+		SacksCounts: map[string]int64{"ENCHANTED_COAL": 64},
+	}}
+	inv, err := m.DecodeInventory()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inv.Containers) != 4 {
+		t.Fatalf("containers = %+v", inv.Containers)
+	}
+
+	items := inv.Container(ContainerInventory)
+	if len(items) != 2 || items[0].Slot != 0 || items[1].Slot != 2 {
+		t.Fatalf("inventory = %+v", items)
+	}
+	if h := items[0]; h.SkyBlockID != "HYPERION" || h.UUID != "abc" || h.Count != 1 || h.Name != "§dHeroic Hyperion" || len(h.Lore) != 1 {
+		t.Errorf("hyperion = %+v", h)
+	}
+	if c := inv.Containers[1]; c.Kind != ContainerBackpack || c.Page != 2 || len(c.Items) != 0 {
+		t.Errorf("first backpack = %+v", c)
+	}
+	if c := inv.Containers[2]; c.Page != 10 || len(c.Items) != 1 || c.Items[0].Slot != 1 {
+		t.Errorf("second backpack = %+v", c)
+	}
+	if bag := inv.Container(ContainerAccessoryBag); len(bag) != 1 || bag[0].SkyBlockID != "SPEED_TALISMAN" {
+		t.Errorf("accessory bag = %+v", bag)
+	}
+	if inv.Sacks["ENCHANTED_COAL"] != 64 {
+		t.Errorf("sacks = %v", inv.Sacks)
+	}
+
+	if _, err := (&ProfileMember{}).DecodeInventory(); !errors.Is(err, ErrInventoryDisabled) {
+		t.Errorf("err = %v, want ErrInventoryDisabled", err)
+	}
+	bad := &ProfileMember{Inventory: &MemberInventory{InvArmor: &EncodedItems{Data: "not base64"}}}
+	if _, err := bad.DecodeInventory(); err == nil {
+		t.Error("expected decode error")
+	}
+}
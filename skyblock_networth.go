@@ -0,0 +1,410 @@
+package hypixel
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Containers that are not NBT encoded, used in a Networth breakdown
+const (
+	ContainerSacks ContainerKind = "sacks"
+	ContainerPets  ContainerKind = "pets"
+)
+
+// ValuePartKind what an ItemValue part prices
+type ValuePartKind string
+
+const (
+	PartEnchantment    ValuePartKind = "enchantment"
+	PartReforge        ValuePartKind = "reforge"
+	PartRecombobulator ValuePartKind = "recombobulator"
+	PartHotPotatoBook  ValuePartKind = "hot_potato_book"
+	PartMasterStar     ValuePartKind = "master_star"
+	PartGemstone       ValuePartKind = "gemstone"
+	PartPetItem        ValuePartKind = "pet_item"
+)
+
+// ReforgeStones reforge modifier to the stone applying it, modifiers missing here are priced at 0
+var ReforgeStones = map[string]string{
+	"withered":  "WITHER_BLOOD",
+	"fabled":    "DRAGON_CLAW",
+	"giant":     "GIANT_TOOTH",
+	"ancient":   "PRECURSOR_GEAR",
+	"renowned":  "DRAGON_HORN",
+	"spiritual": "SPIRIT_DECOY",
+	"jaded":     "JADERALD",
+	"submerged": "DEEP_SEA_ORB",
+	"necrotic":  "NECROMANCER_BROOCH",
+	"gilded":    "MIDAS_JEWEL",
+	"precise":   "OPTICAL_LENS",
+	"loving":    "RED_SCARF",
+	"warped":    "AOTE_STONE",
+}
+
+// masterStars stars 6 to 10 of dungeon items
+var masterStars = []string{"FIRST_MASTER_STAR", "SECOND_MASTER_STAR", "THIRD_MASTER_STAR", "FOURTH_MASTER_STAR", "FIFTH_MASTER_STAR"}
+
+// Prices item prices by SkyBlock id, bazaar prices win over lowest BIN
+// Pets are keyed by PetPriceID, enchantments by "ENCHANTMENT_<NAME>_<LEVEL>" as on the bazaar
+type Prices struct {
+	LowestBIN map[string]float64
+	Bazaar    map[string]float64 // quick_status.sellPrice, what an instant sell earns
+}
+
+// NewPrices empty price tables
+func NewPrices() *Prices {
+	return &Prices{LowestBIN: map[string]float64{}, Bazaar: map[string]float64{}}
+}
+
+// Price unit price of id, false if neither table has a positive price
+func (p *Prices) Price(id string) (float64, bool) {
+	if v := p.Bazaar[id]; v > 0 {
+		return v, true
+	}
+	if v := p.LowestBIN[id]; v > 0 {
+		return v, true
+	}
+	return 0, false
+}
+
+// AddBazaar load a GetBazaar response
+func (p *Prices) AddBazaar(resp Response) error {
+	var body struct {
+		Products map[string]struct {
+			QuickStatus struct {
+				SellPrice float64 `json:"sellPrice"`
+			} `json:"quick_status"`
+		} `json:"products"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return err
+	}
+	for id, product := range body.Products {
+		// no sell orders, leave it to LowestBIN
+		if product.QuickStatus.SellPrice > 0 {
+			p.Bazaar[id] = product.QuickStatus.SellPrice
+		}
+	}
+	return nil
+}
+
+// AddAuctions load one GetActiveAuctions page, keeping the lowest BIN per unit of every item
+// Returns the total page count
+func (p *Prices) AddAuctions(resp Response) (int, error) {
+	var body struct {
+		TotalPages int `json:"totalPages"`
+		Auctions   []struct {
+			BIN         bool            `json:"bin"`
+			StartingBid float64         `json:"starting_bid"`
+			ItemBytes   json.RawMessage `json:"item_bytes"`
+		} `json:"auctions"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return 0, err
+	}
+	for _, a := range body.Auctions {
+		if !a.BIN {
+			continue
+		}
+		var data string
+		if json.Unmarshal(a.ItemBytes, &data) != nil {
+			// older responses wrap the data like EncodedItems
+			var e EncodedItems
+			_ = json.Unmarshal(a.ItemBytes, &e)
+			data = e.Data
+		}
+		items, err := DecodeItems(data)
+		if err != nil || len(items) == 0 {
+			continue
+		}
+		id := itemPriceID(items[0])
+		if id == "" {
+			continue
+		}
+		unit := a.StartingBid / float64(max(items[0].Count, 1))
+		if v, ok := p.LowestBIN[id]; !ok || unit < v {
+			p.LowestBIN[id] = unit
+		}
+	}
+	return body.TotalPages, nil
+}
+
+// Load fill both tables from the bazaar and every active auction page
+func (p *Prices) Load(c *Client) error {
+	resp, err := c.GetBazaar()
+	if err != nil {
+		return err
+	}
+	if err := p.AddBazaar(resp); err != nil {
+		return err
+	}
+	for page, pages := uint(0), uint(1); page < pages; page++ {
+		resp, err := c.GetActiveAuctions(page)
+		if err != nil {
+			return err
+		}
+		n, err := p.AddAuctions(resp)
+		if err != nil {
+			return err
+		}
+		pages = uint(n)
+	}
+	return nil
+}
+
+// petLevelBrackets pet levels priced apart, a pet falls in the highest bracket it reached
+var petLevelBrackets = []int{1, 100, 200}
+
+// PetPriceID price key of a pet with its level bracket, e.g. "PET_GOLDEN_DRAGON_LEGENDARY_LVL_100"
+// Auction pets are keyed the same way from their petInfo exp
+func PetPriceID(p Pet) string {
+	level, bracket := p.Level().Level, petLevelBrackets[0]
+	for _, b := range petLevelBrackets {
+		if level >= b {
+			bracket = b
+		}
+	}
+	return "PET_" + p.Type + "_" + p.Tier + "_LVL_" + strconv.Itoa(bracket)
+}
+
+// itemPet the pet stored in a pet item, false for other items
+func itemPet(it Item) (Pet, bool) {
+	info, ok := it.Attributes["petInfo"].(string)
+	if !ok {
+		return Pet{}, false
+	}
+	var pet Pet
+	if err := json.Unmarshal([]byte(info), &pet); err != nil {
+		return Pet{}, false
+	}
+	return pet, true
+}
+
+// itemPriceID price key of an item, pets use PetPriceID
+func itemPriceID(it Item) string {
+	if pet, ok := itemPet(it); ok {
+		return PetPriceID(pet)
+	}
+	return it.SkyBlockID
+}
+
+// ValuePart one priced upgrade of an item
+type ValuePart struct {
+	Kind  ValuePartKind
+	ID    string
+	Count int
+	Value float64
+}
+
+// ItemValue price of one item stack or pet
+type ItemValue struct {
+	Slot   int
+	ID     string // price key
+	Name   string
+	Count  int
+	Priced bool    // false if ID has no price, Base is 0
+	Base   float64 // unit price times Count
+	Parts  []ValuePart
+	Total  float64
+}
+
+// ItemValue price it and its upgrades
+// Essence stars are not priced, master stars are
+func (p *Prices) ItemValue(it Item) ItemValue {
+	v := ItemValue{Slot: it.Slot, ID: itemPriceID(it), Name: it.Name, Count: max(it.Count, 1)}
+	if price, ok := p.Price(v.ID); ok {
+		v.Priced = true
+		v.Base = price * float64(v.Count)
+	}
+	add := func(kind ValuePartKind, id string, n int) {
+		if n <= 0 {
+			return
+		}
+		if price, ok := p.Price(id); ok {
+			v.Parts = append(v.Parts, ValuePart{Kind: kind, ID: id, Count: n, Value: price * float64(n)})
+		}
+	}
+	attr := func(key string) int {
+		n, _ := nbtNumber(it.Attributes[key])
+		return int(n)
+	}
+
+	enchants, _ := it.Attributes["enchantments"].(map[string]any)
+	for _, name := range slices.Sorted(maps.Keys(enchants)) {
+		if level, ok := nbtNumber(enchants[name]); ok {
+			add(PartEnchantment, "ENCHANTMENT_"+strings.ToUpper(name)+"_"+strconv.FormatInt(level, 10), 1)
+		}
+	}
+	if modifier, ok := it.Attributes["modifier"].(string); ok {
+		if stone, ok := ReforgeStones[modifier]; ok {
+			add(PartReforge, stone, 1)
+		}
+	}
+	if it.Recombobulated() {
+		add(PartRecombobulator, "RECOMBOBULATOR_3000", 1)
+	}
+	books := attr("hot_potato_count")
+	add(PartHotPotatoBook, "HOT_POTATO_BOOK", min(books, 10))
+	add(PartHotPotatoBook, "FUMING_POTATO_BOOK", books-10)
+	stars := max(attr("upgrade_level"), attr("dungeon_item_level"))
+	for i := 0; i < stars-5 && i < len(masterStars); i++ {
+		add(PartMasterStar, masterStars[i], 1)
+	}
+	for _, id := range itemGemstones(it) {
+		add(PartGemstone, id, 1)
+	}
+	if pet, ok := itemPet(it); ok && pet.HeldItem != "" {
+		add(PartPetItem, pet.HeldItem, 1)
+	}
+
+	v.Total = v.Base
+	for _, part := range v.Parts {
+		v.Total += part.Value
+	}
+	return v
+}
+
+// PetValue price a pet and its held item
+func (p *Prices) PetValue(pet Pet) ItemValue {
+	v := ItemValue{Slot: -1, ID: PetPriceID(pet), Name: pet.DisplayName(), Count: 1}
+	if price, ok := p.Price(v.ID); ok {
+		v.Priced = true
+		v.Base = price
+	}
+	if pet.HeldItem != "" {
+		if price, ok := p.Price(pet.HeldItem); ok {
+			v.Parts = append(v.Parts, ValuePart{Kind: PartPetItem, ID: pet.HeldItem, Count: 1, Value: price})
+		}
+	}
+	v.Total = v.Base
+	for _, part := range v.Parts {
+		v.Total += part.Value
+	}
+	return v
+}
+
+// itemGemstones bazaar ids of the applied gemstones, e.g. "PERFECT_SAPPHIRE_GEM"
+// Typed slots ("JADE_0") carry the type in their name, universal slots ("COMBAT_0") in "<slot>_gem"
+func itemGemstones(it Item) []string {
+	gems, _ := it.Attributes["gems"].(map[string]any)
+	var out []string
+	for _, slot := range slices.Sorted(maps.Keys(gems)) {
+		if slot == "unlocked_slots" || strings.HasSuffix(slot, "_gem") {
+			continue
+		}
+		var quality string
+		switch q := gems[slot].(type) {
+		case string:
+			quality = q
+		case map[string]any:
+			quality, _ = q["quality"].(string)
+		}
+		if quality == "" {
+			continue
+		}
+		gem, ok := gems[slot+"_gem"].(string)
+		if !ok {
+			i := strings.LastIndexByte(slot, '_')
+			if i < 0 {
+				continue
+			}
+			gem = slot[:i]
+		}
+		out = append(out, quality+"_"+gem+"_GEM")
+	}
+	return out
+}
+
+// ContainerValue priced items of one container, sorted by Total descending
+type ContainerValue struct {
+	Kind  ContainerKind
+	Page  int
+	Items []ItemValue
+	Total float64
+}
+
+// Networth estimated value of a profile member
+type Networth struct {
+	Total        float64
+	Purse        float64
+	Bank         float64 // shared profile bank
+	PersonalBank float64
+	Essence      float64
+	InventoryAPI bool             // false if the member disabled the inventory API, containers and sacks are missing
+	Containers   []ContainerValue // DecodeInventory order, then sacks and pets
+	Unpriced     []string         // price keys without a price, sorted
+}
+
+// Networth estimate the networth of the member uuid of profile
+// ErrNotFound if uuid is not a member
+func (p *Prices) Networth(profile *Profile, uuid string) (*Networth, error) {
+	m, ok := profile.Member(uuid)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	nw := &Networth{Purse: m.Currencies.CoinPurse, PersonalBank: m.Profile.BankAccount}
+	if profile.Banking != nil {
+		nw.Bank = profile.Banking.Balance
+	}
+	unpriced := map[string]bool{}
+	addContainer := func(kind ContainerKind, page int, values []ItemValue) {
+		cv := ContainerValue{Kind: kind, Page: page, Items: values}
+		for _, v := range values {
+			cv.Total += v.Total
+			if !v.Priced {
+				unpriced[v.ID] = true
+			}
+		}
+		slices.SortStableFunc(cv.Items, func(a, b ItemValue) int { return cmp.Compare(b.Total, a.Total) })
+		nw.Containers = append(nw.Containers, cv)
+	}
+
+	inv, err := m.DecodeInventory()
+	switch {
+	case errors.Is(err, ErrInventoryDisabled):
+	case err != nil:
+		return nil, err
+	default:
+		nw.InventoryAPI = true
+		for _, c := range inv.Containers {
+			values := make([]ItemValue, 0, len(c.Items))
+			for _, it := range c.Items {
+				if it.SkyBlockID != "" {
+					values = append(values, p.ItemValue(it))
+				}
+			}
+			addContainer(c.Kind, c.Page, values)
+		}
+		sacks := make([]ItemValue, 0, len(inv.Sacks))
+		for _, id := range slices.Sorted(maps.Keys(inv.Sacks)) {
+			if n := inv.Sacks[id]; n > 0 {
+				sacks = append(sacks, p.ItemValue(Item{Slot: -1, SkyBlockID: id, Name: id, Count: int(n)}))
+			}
+		}
+		addContainer(ContainerSacks, 0, sacks)
+	}
+
+	pets := make([]ItemValue, 0, len(m.PetsData.Pets))
+	for _, pet := range m.PetsData.Pets {
+		pets = append(pets, p.PetValue(pet))
+	}
+	addContainer(ContainerPets, 0, pets)
+
+	for kind, e := range m.Currencies.Essence {
+		if price, ok := p.Price("ESSENCE_" + strings.ToUpper(kind)); ok {
+			nw.Essence += price * float64(e.Current)
+		}
+	}
+
+	nw.Total = nw.Purse + nw.Bank + nw.PersonalBank + nw.Essence
+	for _, c := range nw.Containers {
+		nw.Total += c.Total
+	}
+	nw.Unpriced = slices.Sorted(maps.Keys(unpriced))
+	return nw, nil
+}
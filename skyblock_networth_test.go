@@ -0,0 +1,182 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func testPrices(t *testing.T) *Prices {
+	t.Helper()
+	p := NewPrices()
+	bazaar := `{"success":true,"products":{
+		"ENCHANTMENT_SHARPNESS_6":{"quick_status":{"sellPrice":100}},
+		"HOT_POTATO_BOOK":{"quick_status":{"sellPrice":10}},
+		"FUMING_POTATO_BOOK":{"quick_status":{"sellPrice":1000}},
+		"RECOMBOBULATOR_3000":{"quick_status":{"sellPrice":5000}},
+		"WITHER_BLOOD":{"quick_status":{"sellPrice":2000}},
+		"FIRST_MASTER_STAR":{"quick_status":{"sellPrice":300}},
+		"PERFECT_SAPPHIRE_GEM":{"quick_status":{"sellPrice":400}},
+		"FINE_JADE_GEM":{"quick_status":{"sellPrice":4}},
+		"ENCHANTED_COAL":{"quick_status":{"sellPrice":2}},
+		"HYPERION":{"quick_status":{"sellPrice":0}},
+		"ESSENCE_WITHER":{"quick_status":{"sellPrice":3}}
+	}}`
+	if err := p.AddBazaar(Response{Content: []byte(bazaar)}); err != nil {
+		t.Fatalf("AddBazaar: %v", err)
+	}
+
+	pet := testItem("PET", "§6Golden Dragon", map[string]any{"petInfo": `{"type":"GOLDEN_DRAGON","tier":"LEGENDARY"}`})
+	maxedPet := testItem("PET", "§6Golden Dragon", map[string]any{"petInfo": `{"type":"GOLDEN_DRAGON","tier":"LEGENDARY","exp":210255385}`})
+	stack := testItem("ENCHANTED_DIAMOND", "§aEnchanted Diamond", nil)
+	stack["Count"] = int8(4)
+	auctions, _ := json.Marshal(map[string]any{
+		"success":    true,
+		"totalPages": 3,
+		"auctions": []map[string]any{
+			{"bin": true, "starting_bid": 1000000, "item_bytes": encodeItems(testItem("HYPERION", "§6Hyperion", nil))},
+			{"bin": true, "starting_bid": 900000, "item_bytes": encodeItems(testItem("HYPERION", "§6Hyperion", nil))},
+			{"bin": false, "starting_bid": 1, "item_bytes": encodeItems(testItem("HYPERION", "§6Hyperion", nil))},
+			{"bin": true, "starting_bid": 800, "item_bytes": encodeItems(stack)},
+			{"bin": true, "starting_bid": 500000, "item_bytes": map[string]any{"type": 0, "data": encodeItems(pet)}},
+			{"bin": true, "starting_bid": 900000000, "item_bytes": encodeItems(maxedPet)},
+			{"bin": true, "starting_bid": 1, "item_bytes": "broken"},
+		},
+	})
+	pages, err := p.AddAuctions(Response{Content: auctions})
+	if err != nil || pages != 3 {
+		t.Fatalf("AddAuctions = %d, %v", pages, err)
+	}
+	return p
+}
+
+func TestPrices_AddAuctions(t *testing.T) {
+	p := testPrices(t)
+	for id, want := range map[string]float64{
+		"HYPERION":                            900000,
+		"ENCHANTED_DIAMOND":                   200,
+		"PET_GOLDEN_DRAGON_LEGENDARY_LVL_1":   500000,
+		"PET_GOLDEN_DRAGON_LEGENDARY_LVL_200": 900000000,
+		"HOT_POTATO_BOOK":                     10,
+	} {
+		if got, ok := p.Price(id); !ok || got != want {
+			t.Errorf("Price(%s) = %v %v, want %v", id, got, ok, want)
+		}
+	}
+}
+
+func TestPrices_ItemValue(t *testing.T) {
+	p := testPrices(t)
+	it := nbtItem(0, testItem("HYPERION", "§6Withered Hyperion", map[string]any{
+		"enchantments":     map[string]any{"sharpness": int32(6), "unknown": int32(1)},
+		"modifier":         "withered",
+		"rarity_upgrades":  int32(1),
+		"hot_potato_count": int32(12),
+		"upgrade_level":    int32(6),
+		"gems":             map[string]any{"COMBAT_0": "PERFECT", "COMBAT_0_gem": "SAPPHIRE", "JADE_0": map[string]any{"quality": "FINE"}, "unlocked_slots": []any{"COMBAT_0"}},
+	}))
+	v := p.ItemValue(it)
+	if !v.Priced || v.Base != 900000 {
+		t.Fatalf("base = %+v", v)
+	}
+	// sharpness 100, withered 2000, recomb 5000, 10 hpb + 2 fuming 2100, master star 300, gems 404
+	if want := 900000.0 + 100 + 2000 + 5000 + 2100 + 300 + 404; v.Total != want {
+		t.Errorf("Total = %v, want %v, parts %+v", v.Total, want, v.Parts)
+	}
+}
+
+func TestPrices_Networth(t *testing.T) {
+	p := testPrices(t)
+	raw, _ := json.Marshal(map[string]any{
+		"banking": map[string]any{"balance": 1000},
+		"members": map[string]any{
+			"abcd": map[string]any{
+				"profile":    map[string]any{"bank_account": 50},
+				"currencies": map[string]any{"coin_purse": 100, "essence": map[string]any{"WITHER": map[string]any{"current": 10}}},
+				"inventory": map[string]any{
+					"inv_contents": map[string]any{"data": encodeItems(
+						testItem("ENCHANTED_DIAMOND", "§aEnchanted Diamond", nil),
+						nil,
+						testItem("HYPERION", "§6Hyperion", nil),
+						testItem("MYSTERY_ITEM", "§fMystery", nil),
+					)},
+					"sacks_counts": map[string]any{"ENCHANTED_COAL": 10, "EMPTY": 0},
+				},
+				"pets_data": map[string]any{"pets": []map[string]any{{"type": "GOLDEN_DRAGON", "tier": "LEGENDARY"}}},
+			},
+			"ef01": map[string]any{"currencies": map[string]any{"coin_purse": 5}},
+		},
+	})
+	var profile Profile
+	if err := json.Unmarshal(raw, &profile); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nw, err := p.Networth(&profile, "ABCD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nw.InventoryAPI || len(nw.Containers) != 3 {
+		t.Fatalf("containers = %+v", nw.Containers)
+	}
+	inv := nw.Containers[0]
+	if inv.Kind != ContainerInventory || inv.Total != 900200 || inv.Items[0].ID != "HYPERION" || inv.Items[0].Slot != 2 {
+		t.Errorf("inventory = %+v", inv)
+	}
+	if sacks := nw.Containers[1]; sacks.Kind != ContainerSacks || sacks.Total != 20 || len(sacks.Items) != 1 {
+		t.Errorf("sacks = %+v", sacks)
+	}
+	if pets := nw.Containers[2]; pets.Kind != ContainerPets || pets.Total != 500000 {
+		t.Errorf("pets = %+v", pets)
+	}
+	if want := 100 + 1000 + 50 + 30 + 900200 + 20 + 500000.0; nw.Total != want {
+		t.Errorf("Total = %v, want %v", nw.Total, want)
+	}
+	if len(nw.Unpriced) != 1 || nw.Unpriced[0] != "MYSTERY_ITEM" {
+		t.Errorf("unpriced = %v", nw.Unpriced)
+	}
+
+	other, err := p.Networth(&profile, "ef01")
+	if err != nil || other.InventoryAPI || other.Total != 1005 {
+		t.Errorf("inventory disabled networth = %+v, %v", other, err)
+	}
+	if _, err := p.Networth(&profile, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPrices_Price(t *testing.T) {
+	p := NewPrices()
+	p.LowestBIN["ITEM"] = 500
+	if err := p.AddBazaar(Response{Content: []byte(`{"success":true,"products":{"ITEM":{"quick_status":{"sellPrice":0}}}}`)}); err != nil {
+		t.Fatalf("AddBazaar: %v", err)
+	}
+	if got, ok := p.Price("ITEM"); !ok || got != 500 {
+		t.Errorf("Price(ITEM) = %v %v; want 500 from LowestBIN", got, ok)
+	}
+	p.Bazaar["ITEM"] = -1
+	if got, ok := p.Price("ITEM"); !ok || got != 500 {
+		t.Errorf("Price(ITEM) with negative bazaar = %v %v; want 500", got, ok)
+	}
+	if _, ok := p.Price("MISSING"); ok {
+		t.Error("Price(MISSING) should be false")
+	}
+}
+
+func TestPetPriceID(t *testing.T) {
+	tests := []struct {
+		pet  Pet
+		want string
+	}{
+		{Pet{Type: "BEE", Tier: "RARE"}, "PET_BEE_RARE_LVL_1"},
+		{Pet{Type: "ENDER_DRAGON", Tier: "LEGENDARY", Exp: 1_000_000}, "PET_ENDER_DRAGON_LEGENDARY_LVL_1"},
+		{Pet{Type: "ENDER_DRAGON", Tier: "LEGENDARY", Exp: 25_353_230}, "PET_ENDER_DRAGON_LEGENDARY_LVL_100"},
+		{Pet{Type: "GOLDEN_DRAGON", Tier: "LEGENDARY", Exp: 100_000_000}, "PET_GOLDEN_DRAGON_LEGENDARY_LVL_100"},
+		{Pet{Type: "GOLDEN_DRAGON", Tier: "LEGENDARY", Exp: 210_255_385}, "PET_GOLDEN_DRAGON_LEGENDARY_LVL_200"},
+	}
+	for _, tt := range tests {
+		if got := PetPriceID(tt.pet); got != tt.want {
+			t.Errorf("PetPriceID(%s %v) = %q; want %q", tt.pet.Type, tt.pet.Exp, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package hypixel
+
+import (
+	"strconv"
+	"strings"
+)
+
+// petLevels experience from level i+1 to i+2, a pet reads it from its rarity offset
+// The tail is only reached by pets going past level 100
+var petLevels = func() []float64 {
+	levels := []float64{
+		100, 110, 120, 130, 145, 160, 175, 190, 210, 230,
+		250, 275, 300, 330, 360, 400, 440, 490, 540, 600,
+		660, 730, 800, 880, 960, 1050, 1150, 1260, 1380, 1510,
+		1650, 1800, 1960, 2130, 2310, 2500, 2700, 2920, 3160, 3420,
+		3700, 4000, 4350, 4750, 5200, 5700, 6300, 7000, 7800, 8700,
+		9700, 10800, 12000, 13300, 14700, 16200, 17800, 19500, 21300, 23200,
+		25200, 27400, 29800, 32400, 35200, 38200, 41400, 44800, 48400, 52200,
+		56200, 60400, 64800, 69400, 74200, 79200, 84700, 90700, 97200, 104200,
+		111700, 119700, 128200, 137200, 146700, 156700, 167700, 179700, 192700, 206700,
+		221700, 237700, 254700, 272700, 291700, 311700, 333700, 357700, 383700, 411700,
+		441700, 476700, 516700, 561700, 611700, 666700, 726700, 791700, 861700, 936700,
+		1016700, 1101700, 1191700, 1286700, 1386700, 1496700, 1616700, 1746700, 1886700,
+		// level 100 to 101 is free, 101 to 102 costs 5555
+		0, 5555,
+	}
+	for range 98 {
+		levels = append(levels, 1886700)
+	}
+	return levels
+}()
+
+// petMaxLevels pets that level past 100
+var petMaxLevels = map[string]int{
+	"GOLDEN_DRAGON": 200,
+	"JADE_DRAGON":   200,
+}
+
+// petRarityOffset where a rarity starts reading petLevels
+func petRarityOffset(r Rarity) int {
+	switch r {
+	case RarityCommon:
+		return 0
+	case RarityUncommon:
+		return 6
+	case RarityRare:
+		return 11
+	case RarityEpic:
+		return 16
+	case RarityLegendary, RarityMythic:
+		return 20
+	case RarityUnknown, RarityDivine, RaritySpecial, RarityVerySpecial, RarityUltimate, RarityAdmin:
+	}
+	return 20
+}
+
+// Rarity parsed Tier
+func (p Pet) Rarity() Rarity {
+	return ParseRarity(p.Tier)
+}
+
+// MaxLevel 100, or 200 for Golden and Jade Dragon
+func (p Pet) MaxLevel() int {
+	if n, ok := petMaxLevels[p.Type]; ok {
+		return n
+	}
+	return 100
+}
+
+// Level pet level from Exp, starting at 1. Overflow is experience past MaxLevel
+func (p Pet) Level() LevelProgress {
+	offset := petRarityOffset(p.Rarity())
+	levels := petLevels[offset : offset+p.MaxLevel()-1]
+	l := tableLevel(cumulative(levels), p.Exp, 0)
+	l.Level++
+	l.Exact++
+	return l
+}
+
+// Name type in title case, "GOLDEN_DRAGON" is "Golden Dragon"
+func (p Pet) Name() string {
+	words := strings.Split(strings.ToLower(p.Type), "_")
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// DisplayName in game name with colour codes, e.g. "§7[Lvl 100] §6Golden Dragon"
+// Skinned pets get a trailing ✦
+func (p Pet) DisplayName() string {
+	name := "§7[Lvl " + strconv.Itoa(p.Level().Level) + "] " + p.Rarity().Color() + p.Name()
+	if p.Skin != "" {
+		name += " ✦"
+	}
+	return name
+}
+
+// Active the pet currently equipped, false if none
+func (m MemberPets) Active() (Pet, bool) {
+	for _, p := range m.Pets {
+		if p.Active {
+			return p, true
+		}
+	}
+	return Pet{}, false
+}
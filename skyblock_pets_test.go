@@ -0,0 +1,46 @@
+package hypixel
+
+import "testing"
+
+func TestPet_Level(t *testing.T) {
+	tests := []struct {
+		pet      Pet
+		level    int
+		maxed    bool
+		overflow float64
+	}{
+		{Pet{Type: "BEE", Tier: "COMMON"}, 1, false, 0},
+		{Pet{Type: "BEE", Tier: "COMMON", Exp: 100}, 2, false, 0},
+		{Pet{Type: "BEE", Tier: "UNCOMMON", Exp: 175}, 2, false, 0},
+		{Pet{Type: "BEE", Tier: "COMMON", Exp: 5_624_785}, 100, true, 0},
+		{Pet{Type: "ENDER_DRAGON", Tier: "LEGENDARY", Exp: 25_353_230 + 10}, 100, true, 10},
+		{Pet{Type: "GOLDEN_DRAGON", Tier: "LEGENDARY", Exp: 25_353_230}, 101, false, 0},
+		{Pet{Type: "GOLDEN_DRAGON", Tier: "LEGENDARY", Exp: 210_255_385}, 200, true, 0},
+	}
+	for _, tt := range tests {
+		got := tt.pet.Level()
+		if got.Level != tt.level || got.Maxed != tt.maxed || got.Overflow != tt.overflow {
+			t.Errorf("%s %s %v: got %+v", tt.pet.Type, tt.pet.Tier, tt.pet.Exp, got)
+		}
+	}
+}
+
+func TestPet_DisplayName(t *testing.T) {
+	p := Pet{Type: "GOLDEN_DRAGON", Tier: "LEGENDARY", Exp: 210_255_385, Skin: "GOLDEN_DRAGON_ANUBIS"}
+	if got, want := p.DisplayName(), "§7[Lvl 200] §6Golden Dragon ✦"; got != want {
+		t.Errorf("DisplayName() = %q, want %q", got, want)
+	}
+	if got, want := (Pet{Type: "BEE", Tier: "RARE"}).DisplayName(), "§7[Lvl 1] §9Bee"; got != want {
+		t.Errorf("DisplayName() = %q, want %q", got, want)
+	}
+}
+
+func TestMemberPets_Active(t *testing.T) {
+	pets := MemberPets{Pets: []Pet{{Type: "BEE"}, {Type: "WOLF", Active: true}}}
+	if p, ok := pets.Active(); !ok || p.Type != "WOLF" {
+		t.Errorf("Active() = %+v %v", p, ok)
+	}
+	if _, ok := (MemberPets{}).Active(); ok {
+		t.Error("expected no active pet")
+	}
+}
@@ -0,0 +1,277 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Profile a SkyBlock profile from GetProfileByUUID or GetProfilesByPlayer
+type Profile struct {
+	ProfileID         string                    `json:"profile_id"`
+	CuteName          string                    `json:"cute_name"` // "Apple", "Banana"...
+	GameMode          string                    `json:"game_mode"` // empty for normal profiles, "ironman", "island", "bingo"
+	Selected          bool                      `json:"selected"`  // only set by GetProfilesByPlayer
+	Banking           *Banking                  `json:"banking"`   // nil when the banking API is disabled
+	CommunityUpgrades json.RawMessage           `json:"community_upgrades"`
+	Members           map[string]*ProfileMember `json:"members"` // keyed by undashed uuid
+}
+
+// Banking the profile's shared bank
+type Banking struct {
+	Balance      float64           `json:"balance"`
+	Transactions []BankTransaction `json:"transactions"`
+}
+
+// BankTransaction one bank deposit or withdrawal
+type BankTransaction struct {
+	Amount        float64 `json:"amount"`
+	Timestamp     int64   `json:"timestamp"` // unix millis
+	Action        string  `json:"action"`    // "DEPOSIT", "WITHDRAW"
+	InitiatorName string  `json:"initiator_name"`
+}
+
+// ProfileMember one member of a profile
+// Sections the player hid through the in game API settings are nil
+type ProfileMember struct {
+	PlayerID            string               `json:"player_id"`
+	Profile             MemberProfile        `json:"profile"`
+	Currencies          Currencies           `json:"currencies"`
+	PlayerData          MemberPlayerData     `json:"player_data"`
+	Leveling            MemberLeveling       `json:"leveling"`
+	JacobsContest       *JacobsContest       `json:"jacobs_contest"`
+	Slayer              *MemberSlayer        `json:"slayer"`
+	Dungeons            *MemberDungeons      `json:"dungeons"`
+	Collection          map[string]int64     `json:"collection"` // nil when the collections API is disabled
+	Inventory           *MemberInventory     `json:"inventory"`  // nil when the inventory API is disabled
+	PetsData            MemberPets           `json:"pets_data"`
+	AccessoryBagStorage *AccessoryBagStorage `json:"accessory_bag_storage"`
+	Rift                MemberRift           `json:"rift"`
+	NetherIsland        MemberNetherIsland   `json:"nether_island_player_data"`
+	raw                 json.RawMessage
+}
+
+// UnmarshalJSON keep the raw member so untyped sections stay reachable through Raw
+func (m *ProfileMember) UnmarshalJSON(data []byte) error {
+	type member ProfileMember
+	var v member
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = ProfileMember(v)
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// Raw the member as returned by the API, for sections without a typed field
+func (m *ProfileMember) Raw() json.RawMessage {
+	return m.raw
+}
+
+// MemberProfile the member's profile metadata
+type MemberProfile struct {
+	FirstJoin           int64   `json:"first_join"` // unix millis
+	BankAccount         float64 `json:"bank_account"`
+	PersonalBankUpgrade int     `json:"personal_bank_upgrade"`
+	CookieBuffActive    bool    `json:"cookie_buff_active"`
+	DeletionNotice      *struct {
+		Timestamp int64 `json:"timestamp"`
+	} `json:"deletion_notice"` // set once the member left or was removed
+}
+
+// Currencies purse and essence
+type Currencies struct {
+	CoinPurse  float64 `json:"coin_purse"`
+	MotesPurse float64 `json:"motes_purse"`
+	Essence    map[string]struct {
+		Current int64 `json:"current"`
+	} `json:"essence"` // keyed by "WITHER", "DRAGON"...
+}
+
+// MemberPlayerData skills and unlocks
+type MemberPlayerData struct {
+	Experience        map[string]float64 `json:"experience"` // "SKILL_FARMING" -> xp, nil when the skills API is disabled
+	UnlockedCollTiers []string           `json:"unlocked_coll_tiers"`
+	CraftedGenerators []string           `json:"crafted_generators"`
+	Perks             map[string]int     `json:"perks"`
+}
+
+// MemberLeveling SkyBlock level experience
+type MemberLeveling struct {
+	Experience float64 `json:"experience"`
+}
+
+// JacobsContest Jacob's farming contest data
+type JacobsContest struct {
+	MedalsInv map[string]int64 `json:"medals_inv"`
+	Perks     map[string]int   `json:"perks"` // "farming_level_cap" raised by Anita
+}
+
+// MemberSlayer slayer progress
+type MemberSlayer struct {
+	SlayerBosses map[string]SlayerBoss `json:"slayer_bosses"` // "zombie", "spider", "wolf", "enderman", "blaze", "vampire"
+}
+
+// SlayerBoss progress on one slayer
+type SlayerBoss struct {
+	XP            float64         `json:"xp"`
+	ClaimedLevels map[string]bool `json:"claimed_levels"`
+	TierKills     [5]int64        `json:"-"` // boss_kills_tier_0 .. boss_kills_tier_4
+}
+
+// UnmarshalJSON collect the boss_kills_tier_N keys into TierKills
+func (b *SlayerBoss) UnmarshalJSON(data []byte) error {
+	type boss SlayerBoss
+	var v boss
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+	for k, raw := range keys {
+		tier, ok := strings.CutPrefix(k, "boss_kills_tier_")
+		if !ok {
+			continue
+		}
+		i, err := strconv.Atoi(tier)
+		if err != nil || i < 0 || i >= len(v.TierKills) {
+			continue
+		}
+		_ = json.Unmarshal(raw, &v.TierKills[i])
+	}
+	*b = SlayerBoss(v)
+	return nil
+}
+
+// MemberDungeons dungeon progress
+type MemberDungeons struct {
+	DungeonTypes  map[string]DungeonType `json:"dungeon_types"` // "catacombs", "master_catacombs"
+	PlayerClasses map[string]struct {
+		Experience float64 `json:"experience"`
+	} `json:"player_classes"` // "healer", "mage", "berserk", "archer", "tank"
+	SelectedDungeonClass string `json:"selected_dungeon_class"`
+	Secrets              int64  `json:"secrets"`
+}
+
+// DungeonType progress in catacombs or master mode, floor maps are keyed by floor number ("0" is the entrance)
+type DungeonType struct {
+	Experience           float64            `json:"experience"`
+	HighestTierCompleted int                `json:"highest_tier_completed"`
+	TimesPlayed          map[string]float64 `json:"times_played"`
+	TierCompletions      map[string]float64 `json:"tier_completions"`
+	FastestTime          map[string]float64 `json:"fastest_time"` // millis, also for the S and S+ variants
+	FastestTimeS         map[string]float64 `json:"fastest_time_s"`
+	FastestTimeSPlus     map[string]float64 `json:"fastest_time_s_plus"`
+	BestScore            map[string]float64 `json:"best_score"`
+	MobsKilled           map[string]float64 `json:"mobs_killed"`
+	WatcherKills         map[string]float64 `json:"watcher_kills"`
+}
+
+// MemberInventory encoded item containers
+// Every container is a gzipped, base64 encoded NBT blob
+type MemberInventory struct {
+	InvContents           *EncodedItems           `json:"inv_contents"`
+	InvArmor              *EncodedItems           `json:"inv_armor"`
+	EquipmentContents     *EncodedItems           `json:"equipment_contents"`
+	EnderChestContents    *EncodedItems           `json:"ender_chest_contents"`
+	WardrobeContents      *EncodedItems           `json:"wardrobe_contents"`
+	PersonalVaultContents *EncodedItems           `json:"personal_vault_contents"`
+	BackpackContents      map[string]EncodedItems `json:"backpack_contents"` // keyed by backpack slot
+	BackpackIcons         map[string]EncodedItems `json:"backpack_icons"`
+	BagContents           map[string]EncodedItems `json:"bag_contents"` // "talisman_bag", "potion_bag", "fishing_bag", "quiver", "sacks_bag"
+	WardrobeEquippedSlot  int                     `json:"wardrobe_equipped_slot"`
+	SacksCounts           map[string]int64        `json:"sacks_counts"`
+}
+
+// EncodedItems one encoded container
+type EncodedItems struct {
+	Type int    `json:"type"`
+	Data string `json:"data"`
+}
+
+// MemberPets pets of a member
+type MemberPets struct {
+	Pets []Pet `json:"pets"`
+}
+
+// Pet one pet
+type Pet struct {
+	UUID      string  `json:"uuid"`
+	Type      string  `json:"type"` // "GOLDEN_DRAGON"...
+	Exp       float64 `json:"exp"`
+	Active    bool    `json:"active"`
+	Tier      string  `json:"tier"` // "LEGENDARY"...
+	HeldItem  string  `json:"heldItem"`
+	CandyUsed int     `json:"candyUsed"`
+	Skin      string  `json:"skin"`
+}
+
+// AccessoryBagStorage accessory bag settings
+type AccessoryBagStorage struct {
+	SelectedPower        string   `json:"selected_power"`
+	HighestMagicalPower  int      `json:"highest_magical_power"`
+	UnlockedPowers       []string `json:"unlocked_powers"`
+	BagUpgradesPurchased int      `json:"bag_upgrades_purchased"`
+}
+
+// MemberRift Rift progress
+type MemberRift struct {
+	Access struct {
+		ConsumedPrism bool `json:"consumed_prism"` // Rift Prism, adds magical power
+	} `json:"access"`
+}
+
+// MemberNetherIsland Crimson Isle data
+type MemberNetherIsland struct {
+	Abiphone struct {
+		ActiveContacts []string `json:"active_contacts"`
+	} `json:"abiphone"`
+}
+
+// ParseProfile decode a GetProfileByUUID response
+// ErrNotFound if the profile does not exist
+func ParseProfile(resp Response) (*Profile, error) {
+	var body struct {
+		Profile *Profile `json:"profile"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	if body.Profile == nil {
+		return nil, ErrNotFound
+	}
+	return body.Profile, nil
+}
+
+// ParseProfiles decode a GetProfilesByPlayer response, empty if the player has no profiles
+func ParseProfiles(resp Response) ([]*Profile, error) {
+	var body struct {
+		Profiles []*Profile `json:"profiles"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	return body.Profiles, nil
+}
+
+// SelectedProfile the profile the player last played on, nil if profiles is empty
+// Falls back to the first profile when none is marked selected
+func SelectedProfile(profiles []*Profile) *Profile {
+	for _, p := range profiles {
+		if p.Selected {
+			return p
+		}
+	}
+	if len(profiles) > 0 {
+		return profiles[0]
+	}
+	return nil
+}
+
+// Member the member with uuid, dashed or not
+func (p *Profile) Member(uuid string) (*ProfileMember, bool) {
+	m, ok := p.Members[strings.ToLower(strings.ReplaceAll(uuid, "-", ""))]
+	return m, ok
+}
@@ -0,0 +1,74 @@
+package hypixel
+
+import (
+	"errors"
+	"testing"
+)
+
+const testProfiles = `{"success":true,"profiles":[
+	{"profile_id":"p1","cute_name":"Apple","members":{}},
+	{"profile_id":"p2","cute_name":"Banana","game_mode":"ironman","selected":true,
+	 "banking":{"balance":1500.5,"transactions":[{"amount":100,"timestamp":1,"action":"DEPOSIT","initiator_name":"Steve"}]},
+	 "members":{"0123456789abcdef0123456789abcdef":{
+		"player_id":"0123456789abcdef0123456789abcdef",
+		"profile":{"first_join":1000,"bank_account":20},
+		"currencies":{"coin_purse":42.5,"essence":{"WITHER":{"current":7}}},
+		"player_data":{"experience":{"SKILL_FARMING":55000}},
+		"jacobs_contest":{"perks":{"farming_level_cap":5}},
+		"slayer":{"slayer_bosses":{"zombie":{"xp":1500,"claimed_levels":{"level_1":true},"boss_kills_tier_0":10,"boss_kills_tier_3":2}}},
+		"dungeons":{"dungeon_types":{"catacombs":{"experience":5000,"tier_completions":{"0":1,"7":3}}},"player_classes":{"mage":{"experience":300}},"secrets":12},
+		"collection":{"WHEAT":100},
+		"pets_data":{"pets":[{"type":"BEE","tier":"RARE","exp":1000,"active":true}]},
+		"garden_player_data":{"copper":3}
+	 }}}
+]}`
+
+func TestParseProfiles(t *testing.T) {
+	profiles, err := ParseProfiles(Response{Content: []byte(testProfiles)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := SelectedProfile(profiles)
+	if p == nil || p.CuteName != "Banana" || p.GameMode != "ironman" || p.Banking.Balance != 1500.5 {
+		t.Fatalf("selected = %+v", p)
+	}
+	m, ok := p.Member("01234567-89ab-cdef-0123-456789ABCDEF")
+	if !ok {
+		t.Fatal("member not found by dashed uuid")
+	}
+	if m.Currencies.CoinPurse != 42.5 || m.Currencies.Essence["WITHER"].Current != 7 || m.Profile.BankAccount != 20 {
+		t.Errorf("currencies = %+v", m.Currencies)
+	}
+	if m.PlayerData.Experience["SKILL_FARMING"] != 55000 || m.JacobsContest.Perks["farming_level_cap"] != 5 {
+		t.Errorf("skills = %+v", m.PlayerData)
+	}
+	if z := m.Slayer.SlayerBosses["zombie"]; z.XP != 1500 || z.TierKills != [5]int64{10, 0, 0, 2, 0} || !z.ClaimedLevels["level_1"] {
+		t.Errorf("zombie = %+v", z)
+	}
+	if c := m.Dungeons.DungeonTypes["catacombs"]; c.Experience != 5000 || c.TierCompletions["7"] != 3 || m.Dungeons.Secrets != 12 {
+		t.Errorf("dungeons = %+v", m.Dungeons)
+	}
+	if len(m.PetsData.Pets) != 1 || !m.PetsData.Pets[0].Active || m.Collection["WHEAT"] != 100 {
+		t.Errorf("pets = %+v", m.PetsData)
+	}
+	if m.Inventory != nil {
+		t.Error("missing inventory should be nil")
+	}
+	if FlattenNumbers(m.Raw())["garden_player_data.copper"] != 3 {
+		t.Error("raw member missing untyped section")
+	}
+
+	if SelectedProfile(profiles[:1]).CuteName != "Apple" || SelectedProfile(nil) != nil {
+		t.Error("SelectedProfile fallback")
+	}
+}
+
+func TestParseProfile(t *testing.T) {
+	p, err := ParseProfile(Response{Content: []byte(`{"success":true,"profile":{"profile_id":"p1","cute_name":"Apple","members":{}}}`)})
+	if err != nil || p.CuteName != "Apple" {
+		t.Errorf("got %+v, %v", p, err)
+	}
+	if _, err := ParseProfile(Response{Content: []byte(`{"success":true,"profile":null}`)}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("null profile: err = %v", err)
+	}
+}
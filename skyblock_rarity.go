@@ -0,0 +1,106 @@
+package hypixel
+
+import "strings"
+
+// Rarity SkyBlock item and pet rarity, ordered from lowest to highest tier
+type Rarity uint8
+
+const (
+	RarityUnknown Rarity = iota
+	RarityCommon
+	RarityUncommon
+	RarityRare
+	RarityEpic
+	RarityLegendary
+	RarityMythic
+	RarityDivine
+	RaritySpecial
+	RarityVerySpecial
+	RarityUltimate
+	RarityAdmin
+)
+
+// ParseRarity "LEGENDARY", "VERY_SPECIAL" or "very special", RarityUnknown otherwise
+func ParseRarity(s string) Rarity {
+	switch strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(s)), " ", "_") {
+	case "COMMON":
+		return RarityCommon
+	case "UNCOMMON":
+		return RarityUncommon
+	case "RARE":
+		return RarityRare
+	case "EPIC":
+		return RarityEpic
+	case "LEGENDARY":
+		return RarityLegendary
+	case "MYTHIC":
+		return RarityMythic
+	case "DIVINE":
+		return RarityDivine
+	case "SPECIAL":
+		return RaritySpecial
+	case "VERY_SPECIAL":
+		return RarityVerySpecial
+	case "ULTIMATE":
+		return RarityUltimate
+	case "ADMIN":
+		return RarityAdmin
+	}
+	return RarityUnknown
+}
+
+// String API name, e.g. "VERY_SPECIAL"
+func (r Rarity) String() string {
+	switch r {
+	case RarityCommon:
+		return "COMMON"
+	case RarityUncommon:
+		return "UNCOMMON"
+	case RarityRare:
+		return "RARE"
+	case RarityEpic:
+		return "EPIC"
+	case RarityLegendary:
+		return "LEGENDARY"
+	case RarityMythic:
+		return "MYTHIC"
+	case RarityDivine:
+		return "DIVINE"
+	case RaritySpecial:
+		return "SPECIAL"
+	case RarityVerySpecial:
+		return "VERY_SPECIAL"
+	case RarityUltimate:
+		return "ULTIMATE"
+	case RarityAdmin:
+		return "ADMIN"
+	case RarityUnknown:
+	}
+	return "UNKNOWN"
+}
+
+// Color colour code used in names and lore
+func (r Rarity) Color() string {
+	switch r {
+	case RarityCommon:
+		return "§f"
+	case RarityUncommon:
+		return "§a"
+	case RarityRare:
+		return "§9"
+	case RarityEpic:
+		return "§5"
+	case RarityLegendary:
+		return "§6"
+	case RarityMythic:
+		return "§d"
+	case RarityDivine:
+		return "§b"
+	case RaritySpecial, RarityVerySpecial:
+		return "§c"
+	case RarityUltimate, RarityAdmin:
+		return "§4"
+	case RarityUnknown:
+	}
+	return "§7"
+}
@@ -0,0 +1,26 @@
+package hypixel
+
+import "testing"
+
+func TestParseRarity(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Rarity
+	}{
+		{"LEGENDARY", RarityLegendary},
+		{"very special", RarityVerySpecial},
+		{"VERY_SPECIAL", RarityVerySpecial},
+		{" mythic ", RarityMythic},
+		{"shiny", RarityUnknown},
+	}
+	for _, tt := range tests {
+		if got := ParseRarity(tt.in); got != tt.want {
+			t.Errorf("ParseRarity(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	for r := RarityCommon; r <= RarityAdmin; r++ {
+		if ParseRarity(r.String()) != r {
+			t.Errorf("round trip of %v failed", r)
+		}
+	}
+}
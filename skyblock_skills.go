@@ -0,0 +1,103 @@
+package hypixel
+
+import (
+	"slices"
+	"strings"
+)
+
+// SkillLevelInfo one level of a skill from GetSkyBlockSkills
+type SkillLevelInfo struct {
+	Level            int      `json:"level"`
+	TotalExpRequired float64  `json:"totalExpRequired"`
+	Unlocks          []string `json:"unlocks"`
+}
+
+// SkillInfo a skill from GetSkyBlockSkills
+type SkillInfo struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	MaxLevel    int              `json:"maxLevel"`
+	Levels      []SkillLevelInfo `json:"levels"`
+}
+
+// Skills GetSkyBlockSkills keyed by skill id ("FARMING", "MINING"...)
+type Skills map[string]SkillInfo
+
+// ParseSkills decode a GetSkyBlockSkills response
+func ParseSkills(resp Response) (Skills, error) {
+	var body struct {
+		Skills Skills `json:"skills"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return nil, err
+	}
+	return body.Skills, nil
+}
+
+// CosmeticSkills skills left out of the skill average
+var CosmeticSkills = []string{"RUNECRAFTING", "SOCIAL"}
+
+// farming is capped at 50 until Anita's perk raises it, up to the resource max level
+const farmingBaseCap = 50
+
+// Level level of skill for exp, capped at maxLevel (0 means the skill's MaxLevel)
+func (s Skills) Level(skill string, exp float64, maxLevel int) LevelProgress {
+	info, ok := s[strings.ToUpper(skill)]
+	if !ok || len(info.Levels) == 0 {
+		return LevelProgress{}
+	}
+	levels := slices.Clone(info.Levels)
+	slices.SortFunc(levels, func(a, b SkillLevelInfo) int { return a.Level - b.Level })
+	total := []float64{0}
+	for _, l := range levels {
+		total = append(total, l.TotalExpRequired)
+	}
+	if maxLevel <= 0 || (info.MaxLevel > 0 && maxLevel > info.MaxLevel) {
+		maxLevel = info.MaxLevel
+	}
+	return tableLevel(total, exp, maxLevel)
+}
+
+// MemberSkills skill levels of a profile member
+type MemberSkills struct {
+	APIEnabled   bool                     // false when the member hides skills, everything else is empty
+	Levels       map[string]LevelProgress // keyed by skill id
+	Average      float64                  // integer levels, cosmetic skills excluded
+	ExactAverage float64                  // levels with progress, cosmetic skills excluded
+}
+
+// MemberSkills compute every skill of m
+// Farming is capped at 50 plus the farming_level_cap perk bought from Anita
+func (s Skills) MemberSkills(m *ProfileMember) MemberSkills {
+	out := MemberSkills{Levels: map[string]LevelProgress{}}
+	if m.PlayerData.Experience == nil {
+		return out
+	}
+	out.APIEnabled = true
+
+	var sum, exactSum float64
+	n := 0
+	for id := range s {
+		exp := m.PlayerData.Experience["SKILL_"+id]
+		maxLevel := 0
+		if id == "FARMING" {
+			maxLevel = farmingBaseCap
+			if m.JacobsContest != nil {
+				maxLevel += m.JacobsContest.Perks["farming_level_cap"]
+			}
+		}
+		l := s.Level(id, exp, maxLevel)
+		out.Levels[id] = l
+		if slices.Contains(CosmeticSkills, id) {
+			continue
+		}
+		sum += float64(l.Level)
+		exactSum += l.Exact
+		n++
+	}
+	if n > 0 {
+		out.Average = sum / float64(n)
+		out.ExactAverage = exactSum / float64(n)
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package hypixel
+
+import "testing"
+
+const testSkills = `{"success":true,"skills":{
+	"FARMING":{"name":"Farming","maxLevel":4,"levels":[{"level":1,"totalExpRequired":50},{"level":2,"totalExpRequired":175},{"level":3,"totalExpRequired":375},{"level":4,"totalExpRequired":675}]},
+	"MINING":{"name":"Mining","maxLevel":3,"levels":[{"level":2,"totalExpRequired":175},{"level":1,"totalExpRequired":50},{"level":3,"totalExpRequired":375}]},
+	"SOCIAL":{"name":"Social","maxLevel":2,"levels":[{"level":1,"totalExpRequired":50},{"level":2,"totalExpRequired":150}]}
+}}`
+
+func TestSkills_Level(t *testing.T) {
+	skills, err := ParseSkills(Response{Content: []byte(testSkills)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		skill    string
+		exp      float64
+		maxLevel int
+		level    int
+		progress float64
+		overflow float64
+	}{
+		{"MINING", 0, 0, 0, 0, 0},
+		{"MINING", 25, 0, 0, 0.5, 0},
+		{"mining", 112.5, 0, 1, 0.5, 0},
+		{"MINING", 500, 0, 3, 0, 125},
+		{"FARMING", 500, 2, 2, 0, 325},
+		{"FARMING", 500, 10, 3, 0.4167, 0},
+	}
+	for _, tt := range tests {
+		got := skills.Level(tt.skill, tt.exp, tt.maxLevel)
+		if got.Level != tt.level || got.Overflow != tt.overflow || got.Progress-tt.progress > 1e-4 || tt.progress-got.Progress > 1e-4 {
+			t.Errorf("Level(%s, %v, %d) = %+v", tt.skill, tt.exp, tt.maxLevel, got)
+		}
+	}
+}
+
+func TestSkills_MemberSkills(t *testing.T) {
+	skills, _ := ParseSkills(Response{Content: []byte(testSkills)})
+	m := &ProfileMember{PlayerData: MemberPlayerData{Experience: map[string]float64{
+		"SKILL_FARMING": 700, "SKILL_MINING": 112.5, "SKILL_SOCIAL": 150,
+	}}}
+	got := skills.MemberSkills(m)
+	// farming cap 50 is above the test table max of 4
+	if !got.APIEnabled || got.Levels["FARMING"].Level != 4 || got.Levels["SOCIAL"].Level != 2 {
+		t.Errorf("levels = %+v", got.Levels)
+	}
+	if got.Average != 2.5 || got.ExactAverage != 2.75 {
+		t.Errorf("average = %v exact = %v", got.Average, got.ExactAverage)
+	}
+	if skills.MemberSkills(&ProfileMember{}).APIEnabled {
+		t.Error("hidden skills should report APIEnabled false")
+	}
+}
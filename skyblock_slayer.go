@@ -0,0 +1,95 @@
+package hypixel
+
+// SlayerInfo static data of a slayer
+type SlayerInfo struct {
+	ID     string    // key in MemberSlayer.SlayerBosses
+	Name   string    // boss name
+	Levels []float64 // total xp required for each level
+	Costs  []int64   // cost to start each tier
+	Motes  bool      // Costs are motes instead of coins
+}
+
+// SlayerTypes every slayer, in in game order
+var SlayerTypes = []SlayerInfo{
+	{
+		ID: "zombie", Name: "Revenant Horror",
+		Levels: []float64{5, 15, 200, 1000, 5000, 20000, 100000, 400000, 1000000},
+		Costs:  []int64{2000, 7500, 20000, 50000, 100000},
+	},
+	{
+		ID: "spider", Name: "Tarantula Broodfather",
+		Levels: []float64{5, 25, 200, 1000, 5000, 20000, 100000, 400000, 1000000},
+		Costs:  []int64{2000, 7500, 20000, 50000, 100000},
+	},
+	{
+		ID: "wolf", Name: "Sven Packmaster",
+		Levels: []float64{10, 30, 250, 1500, 5000, 20000, 100000, 400000, 1000000},
+		Costs:  []int64{2000, 7500, 20000, 50000},
+	},
+	{
+		ID: "enderman", Name: "Voidgloom Seraph",
+		Levels: []float64{10, 30, 250, 1500, 5000, 20000, 100000, 400000, 1000000},
+		Costs:  []int64{2000, 7500, 20000, 50000},
+	},
+	{
+		ID: "blaze", Name: "Inferno Demonlord",
+		Levels: []float64{10, 30, 250, 1500, 5000, 20000, 100000, 400000, 1000000},
+		Costs:  []int64{10000, 25000, 60000, 150000},
+	},
+	{
+		ID: "vampire", Name: "Riftstalker Bloodfiend",
+		Levels: []float64{20, 75, 240, 840, 2400},
+		Costs:  []int64{2000, 7500, 20000, 50000, 150000},
+		Motes:  true,
+	},
+}
+
+// SlayerBossProgress one slayer of a member
+type SlayerBossProgress struct {
+	SlayerInfo
+	XP        float64
+	Level     LevelProgress // Overflow is xp past the max level
+	TierKills [5]int64      // index 0 is tier I
+	Kills     int64
+	Spent     int64 // estimate from TierKills and Costs, ignores discounts, in coins or motes
+}
+
+// SlayerProgress slayer progress of a member
+type SlayerProgress struct {
+	Bosses     []SlayerBossProgress // in SlayerTypes order
+	TotalXP    float64
+	CoinsSpent int64
+	MotesSpent int64
+}
+
+// SlayerProgress false if the member has no slayer data
+func (m *ProfileMember) SlayerProgress() (SlayerProgress, bool) {
+	if m.Slayer == nil {
+		return SlayerProgress{}, false
+	}
+	var out SlayerProgress
+	for _, info := range SlayerTypes {
+		boss := m.Slayer.SlayerBosses[info.ID]
+		total := append([]float64{0}, info.Levels...)
+		p := SlayerBossProgress{
+			SlayerInfo: info,
+			XP:         boss.XP,
+			Level:      tableLevel(total, boss.XP, 0),
+			TierKills:  boss.TierKills,
+		}
+		for i, kills := range boss.TierKills {
+			p.Kills += kills
+			if i < len(info.Costs) {
+				p.Spent += kills * info.Costs[i]
+			}
+		}
+		out.TotalXP += p.XP
+		if info.Motes {
+			out.MotesSpent += p.Spent
+		} else {
+			out.CoinsSpent += p.Spent
+		}
+		out.Bosses = append(out.Bosses, p)
+	}
+	return out, true
+}
@@ -0,0 +1,43 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProfileMember_SlayerProgress(t *testing.T) {
+	var m ProfileMember
+	err := json.Unmarshal([]byte(`{"slayer":{"slayer_bosses":{
+		"zombie":{"xp":1500,"boss_kills_tier_0":10,"boss_kills_tier_3":2},
+		"wolf":{"xp":1200000,"boss_kills_tier_1":4},
+		"vampire":{"xp":100,"boss_kills_tier_0":3}
+	}}}`), &m)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s, ok := m.SlayerProgress()
+	if !ok {
+		t.Fatal("expected slayer data")
+	}
+	if len(s.Bosses) != len(SlayerTypes) {
+		t.Fatalf("bosses = %d", len(s.Bosses))
+	}
+
+	zombie, wolf, vampire := s.Bosses[0], s.Bosses[2], s.Bosses[5]
+	if zombie.ID != "zombie" || zombie.Level.Level != 4 || zombie.Kills != 12 || zombie.Spent != 10*2000+2*50000 {
+		t.Errorf("zombie = %+v", zombie)
+	}
+	if wolf.Level.Level != 9 || !wolf.Level.Maxed || wolf.Level.Overflow != 200000 {
+		t.Errorf("wolf = %+v", wolf.Level)
+	}
+	if vampire.Level.Level != 2 || !vampire.Motes {
+		t.Errorf("vampire = %+v", vampire)
+	}
+	if s.TotalXP != 1201600 || s.CoinsSpent != 120000+4*7500 || s.MotesSpent != 6000 {
+		t.Errorf("totals = %v %v %v", s.TotalXP, s.CoinsSpent, s.MotesSpent)
+	}
+
+	if _, ok := (&ProfileMember{}).SlayerProgress(); ok {
+		t.Error("expected no slayer data")
+	}
+}
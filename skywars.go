@@ -0,0 +1,146 @@
+package hypixel
+
+import "strconv"
+
+// SkyWars mode ids, the stat key suffix used by the API
+const (
+	SkyWarsSoloNormal = "solo_normal"
+	SkyWarsSoloInsane = "solo_insane"
+	SkyWarsTeamNormal = "team_normal"
+	SkyWarsTeamInsane = "team_insane"
+)
+
+// SkyWarsModes every mode read by Player.SkyWars
+var SkyWarsModes = []string{SkyWarsSoloNormal, SkyWarsSoloInsane, SkyWarsTeamNormal, SkyWarsTeamInsane}
+
+// SkyWarsMode counters of one mode, or of all modes for SkyWars.Overall
+type SkyWarsMode struct {
+	Kills  int64
+	Deaths int64
+	Wins   int64
+	Losses int64
+	Heads  int64
+}
+
+func (m SkyWarsMode) KDR() float64 { return Ratio(m.Kills, m.Deaths) }
+func (m SkyWarsMode) WLR() float64 { return Ratio(m.Wins, m.Losses) }
+
+// SkyWars typed view of player.stats.SkyWars
+type SkyWars struct {
+	Overall    SkyWarsMode
+	Modes      map[string]SkyWarsMode // keyed by the SkyWars mode ids, played modes only
+	Experience float64
+	Souls      int64
+	Coins      int64
+}
+
+// SkyWars build the SkyWars view from the player's stats
+func (p *Player) SkyWars() SkyWars {
+	s := p.GameStats("SkyWars")
+	sw := SkyWars{
+		Overall:    skyWarsMode(s, ""),
+		Modes:      map[string]SkyWarsMode{},
+		Experience: s.Float("skywars_experience"),
+		Souls:      s.Int("souls"),
+		Coins:      s.Int("coins"),
+	}
+	for _, mode := range SkyWarsModes {
+		if m := skyWarsMode(s, "_"+mode); m.Wins > 0 || m.Losses > 0 || m.Kills > 0 || m.Deaths > 0 {
+			sw.Modes[mode] = m
+		}
+	}
+	return sw
+}
+
+func skyWarsMode(s Stats, suffix string) SkyWarsMode {
+	return SkyWarsMode{
+		Kills:  s.Int("kills" + suffix),
+		Deaths: s.Int("deaths" + suffix),
+		Wins:   s.Int("wins" + suffix),
+		Losses: s.Int("losses" + suffix),
+		Heads:  s.Int("heads" + suffix),
+	}
+}
+
+// skyWarsLevelExp total experience for levels 1..12, every later level costs skyWarsExpPerLevel
+var skyWarsLevelExp = []float64{0, 20, 70, 150, 250, 500, 1000, 2000, 3500, 6000, 10000, 15000}
+
+const skyWarsExpPerLevel = 10000
+
+// Level exact level and progress from skywars_experience
+func (sw SkyWars) Level() LevelProgress {
+	return SkyWarsLevel(sw.Experience)
+}
+
+// Prestige prestige of the current level
+func (sw SkyWars) Prestige() SkyWarsPrestige {
+	return SkyWarsPrestigeOf(sw.Level().Level)
+}
+
+// SkyWarsLevel exact SkyWars level and progress from skywars_experience
+func SkyWarsLevel(exp float64) LevelProgress {
+	if exp < 0 {
+		exp = 0
+	}
+	last := len(skyWarsLevelExp) - 1
+	if exp < skyWarsLevelExp[last] {
+		level := 1
+		for level < last && exp >= skyWarsLevelExp[level] {
+			level++
+		}
+		into := exp - skyWarsLevelExp[level-1]
+		span := skyWarsLevelExp[level] - skyWarsLevelExp[level-1]
+		return LevelProgress{Level: level, Exact: float64(level) + into/span, Progress: into / span, XP: into, XPToNext: span}
+	}
+	past := exp - skyWarsLevelExp[last]
+	n := int(past / skyWarsExpPerLevel)
+	into := past - float64(n)*skyWarsExpPerLevel
+	level := last + 1 + n
+	return LevelProgress{
+		Level:    level,
+		Exact:    float64(level) + into/skyWarsExpPerLevel,
+		Progress: into / skyWarsExpPerLevel,
+		XP:       into,
+		XPToNext: skyWarsExpPerLevel,
+	}
+}
+
+// SkyWarsPrestige the prestige of a level
+type SkyWarsPrestige struct {
+	Name  string
+	Color string
+	Level int // first level of the prestige
+}
+
+// skyWarsPrestiges ordered by starting level
+var skyWarsPrestiges = []SkyWarsPrestige{
+	{"None", "§7", 1},
+	{"Iron", "§f", 5},
+	{"Gold", "§6", 10},
+	{"Diamond", "§b", 15},
+	{"Emerald", "§2", 20},
+	{"Sapphire", "§3", 25},
+	{"Ruby", "§4", 30},
+	{"Crystal", "§d", 35},
+	{"Opal", "§9", 40},
+	{"Amethyst", "§5", 45},
+	{"Rainbow", "§6", 50},
+	{"Mythic", "§d", 60},
+}
+
+// SkyWarsPrestigeOf prestige of level
+func SkyWarsPrestigeOf(level int) SkyWarsPrestige {
+	p := skyWarsPrestiges[0]
+	for _, next := range skyWarsPrestiges[1:] {
+		if level < next.Level {
+			break
+		}
+		p = next
+	}
+	return p
+}
+
+// FormatSkyWarsLevel colour coded level tag, e.g. "§6[12⋆]"
+func FormatSkyWarsLevel(level int) string {
+	return SkyWarsPrestigeOf(level).Color + "[" + strconv.Itoa(level) + "⋆]"
+}
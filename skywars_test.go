@@ -0,0 +1,62 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSkyWarsLevel(t *testing.T) {
+	tests := []struct {
+		exp   float64
+		level int
+		prog  float64
+	}{
+		{0, 1, 0},
+		{10, 1, 0.5},
+		{20, 2, 0},
+		{14999, 11, 0.9998},
+		{15000, 12, 0},
+		{20000, 12, 0.5},
+		{25000, 13, 0},
+		{15000 + 10000*40, 52, 0},
+	}
+	for _, tt := range tests {
+		got := SkyWarsLevel(tt.exp)
+		if got.Level != tt.level || got.Progress-tt.prog > 1e-4 || tt.prog-got.Progress > 1e-4 {
+			t.Errorf("SkyWarsLevel(%v) = %d %.4f; want %d %.4f", tt.exp, got.Level, got.Progress, tt.level, tt.prog)
+		}
+	}
+}
+
+func TestSkyWarsPrestige(t *testing.T) {
+	tests := map[int]string{1: "None", 4: "None", 5: "Iron", 12: "Gold", 52: "Rainbow", 75: "Mythic"}
+	for level, want := range tests {
+		if got := SkyWarsPrestigeOf(level).Name; got != want {
+			t.Errorf("SkyWarsPrestigeOf(%d) = %s; want %s", level, got, want)
+		}
+	}
+	if got := FormatSkyWarsLevel(12); got != "§6[12⋆]" {
+		t.Errorf("FormatSkyWarsLevel(12) = %q", got)
+	}
+}
+
+func TestPlayer_SkyWars(t *testing.T) {
+	p := &Player{Stats: map[string]json.RawMessage{"SkyWars": json.RawMessage(`{
+		"skywars_experience": 20000, "souls": 40, "heads": 3,
+		"kills": 20, "deaths": 10, "wins": 6, "losses": 4,
+		"kills_solo_insane": 5, "deaths_solo_insane": 2, "wins_solo_insane": 1
+	}`)}}
+	sw := p.SkyWars()
+	if sw.Level().Level != 12 || sw.Prestige().Name != "Gold" || sw.Souls != 40 || sw.Overall.Heads != 3 {
+		t.Errorf("got %+v", sw)
+	}
+	if sw.Overall.KDR() != 2 || sw.Overall.WLR() != 1.5 {
+		t.Errorf("overall = %+v", sw.Overall)
+	}
+	if m, ok := sw.Modes[SkyWarsSoloInsane]; !ok || m.KDR() != 2.5 {
+		t.Errorf("solo insane = %+v", m)
+	}
+	if len(sw.Modes) != 1 {
+		t.Errorf("modes = %v; want only played", sw.Modes)
+	}
+}
@@ -0,0 +1,133 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// PlayerSnapshot numeric state of a player at one point in time
+// It marshals to JSON so a session start can be stored and diffed later
+type PlayerSnapshot struct {
+	Time                time.Time                     `json:"time"`
+	NetworkExp          float64                       `json:"networkExp"`
+	Karma               int64                         `json:"karma"`
+	AchievementsOneTime []string                      `json:"achievementsOneTime"`
+	Achievements        map[string]int64              `json:"achievements"`
+	Stats               map[string]map[string]float64 `json:"stats"` // game -> flattened numeric stats
+}
+
+// Snapshot capture the numeric state of the player now
+// Every game in Stats is flattened with FlattenNumbers, no game specific code involved
+func (p *Player) Snapshot() PlayerSnapshot {
+	s := PlayerSnapshot{
+		Time:                time.Now(),
+		NetworkExp:          p.NetworkExp,
+		Karma:               p.Karma,
+		AchievementsOneTime: slices.Clone([]string(p.AchievementsOneTime)),
+		Achievements:        map[string]int64{},
+		Stats:               map[string]map[string]float64{},
+	}
+	for k, v := range p.Achievements {
+		s.Achievements[k] = v
+	}
+	for game, raw := range p.Stats {
+		if flat := FlattenNumbers(raw); len(flat) > 0 {
+			s.Stats[game] = flat
+		}
+	}
+	return s
+}
+
+// SnapshotDiff what changed between two snapshots
+type SnapshotDiff struct {
+	Duration           time.Duration
+	NetworkExp         float64
+	NetworkLevels      float64 // exact network levels gained
+	Karma              int64
+	NewAchievements    []string                      // one time achievements unlocked
+	TieredAchievements map[string]int64              // tiered progress gained
+	Games              map[string]map[string]float64 // game -> stat -> delta, changed stats only
+}
+
+// Diff changes from s to later
+func (s PlayerSnapshot) Diff(later PlayerSnapshot) SnapshotDiff {
+	d := SnapshotDiff{
+		Duration:           later.Time.Sub(s.Time),
+		NetworkExp:         later.NetworkExp - s.NetworkExp,
+		NetworkLevels:      NetworkLevel(later.NetworkExp).Exact - NetworkLevel(s.NetworkExp).Exact,
+		Karma:              later.Karma - s.Karma,
+		TieredAchievements: map[string]int64{},
+		Games:              map[string]map[string]float64{},
+	}
+	for _, a := range later.AchievementsOneTime {
+		if !slices.Contains(s.AchievementsOneTime, a) {
+			d.NewAchievements = append(d.NewAchievements, a)
+		}
+	}
+	for k, v := range later.Achievements {
+		if delta := v - s.Achievements[k]; delta != 0 {
+			d.TieredAchievements[k] = delta
+		}
+	}
+	for game, stats := range later.Stats {
+		if delta := DiffNumbers(s.Stats[game], stats); len(delta) > 0 {
+			d.Games[game] = delta
+		}
+	}
+	return d
+}
+
+// Game deltas of one game ("Bedwars", "SkyWars", "Duels", "SkyBlock"...), empty if unchanged
+func (d SnapshotDiff) Game(name string) Stats {
+	out := Stats{}
+	for k, v := range d.Games[name] {
+		out[k] = v
+	}
+	return out
+}
+
+// FlattenNumbers every number in a JSON document by dotted path, e.g. "packages.0.level"
+// Use it with DiffNumbers to diff other documents such as a SkyBlock profile member
+func FlattenNumbers(raw json.RawMessage) map[string]float64 {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+	out := map[string]float64{}
+	flattenNumbers(v, "", out)
+	return out
+}
+
+func flattenNumbers(v any, prefix string, out map[string]float64) {
+	join := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+	switch v := v.(type) {
+	case float64:
+		out[prefix] = v
+	case map[string]any:
+		for k, e := range v {
+			flattenNumbers(e, join(k), out)
+		}
+	case []any:
+		for i, e := range v {
+			flattenNumbers(e, join(strconv.Itoa(i)), out)
+		}
+	}
+}
+
+// DiffNumbers after minus before for every key that changed, keys missing before count as 0
+func DiffNumbers(before, after map[string]float64) map[string]float64 {
+	out := map[string]float64{}
+	for k, v := range after {
+		if delta := v - before[k]; delta != 0 {
+			out[k] = delta
+		}
+	}
+	return out
+}
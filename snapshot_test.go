@@ -0,0 +1,58 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPlayer_SnapshotDiff(t *testing.T) {
+	before, err := ParsePlayer(Response{Content: []byte(`{"success":true,"player":{
+		"networkExp":10000,"karma":5,"achievementsOneTime":["bedwars_first",[]],"achievements":{"bedwars_wins":10},
+		"stats":{"Bedwars":{"wins_bedwars":10,"Experience":500,"favourites":"x"},"Duels":{"wins":3}}}}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	after, _ := ParsePlayer(Response{Content: []byte(`{"success":true,"player":{
+		"networkExp":22500,"karma":5,"achievementsOneTime":["bedwars_first","duels_first"],"achievements":{"bedwars_wins":12},
+		"stats":{"Bedwars":{"wins_bedwars":12,"Experience":1500,"eight_one_wins_bedwars":2},"Duels":{"wins":3},
+		"SkyBlock":{"profiles":{"p1":{"cute_name":"Apple"}}}}}}`)})
+
+	start := before.Snapshot()
+	// snapshots survive a round trip through storage
+	raw, _ := json.Marshal(start)
+	var stored PlayerSnapshot
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		t.Fatalf("unmarshal snapshot: %v", err)
+	}
+	d := stored.Diff(after.Snapshot())
+
+	if d.NetworkExp != 12500 || d.NetworkLevels != 1 || d.Karma != 0 {
+		t.Errorf("exp=%v levels=%v karma=%d", d.NetworkExp, d.NetworkLevels, d.Karma)
+	}
+	if len(d.NewAchievements) != 1 || d.NewAchievements[0] != "duels_first" || d.TieredAchievements["bedwars_wins"] != 2 {
+		t.Errorf("achievements new=%v tiered=%v", d.NewAchievements, d.TieredAchievements)
+	}
+	bw := d.Game("Bedwars")
+	if bw.Int("wins_bedwars") != 2 || bw.Int("Experience") != 1000 || bw.Int("eight_one_wins_bedwars") != 2 {
+		t.Errorf("bedwars diff = %v", bw)
+	}
+	if _, ok := d.Games["Duels"]; ok {
+		t.Error("unchanged game in diff")
+	}
+}
+
+func TestFlattenNumbers(t *testing.T) {
+	got := FlattenNumbers(json.RawMessage(`{"a":1,"b":{"c":2,"d":"x"},"e":[3,{"f":4}]}`))
+	want := map[string]float64{"a": 1, "b.c": 2, "e.0": 3, "e.1.f": 4}
+	if len(got) != len(want) {
+		t.Fatalf("FlattenNumbers() = %v; want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %v; want %v", k, got[k], v)
+		}
+	}
+	if d := DiffNumbers(map[string]float64{"a": 1, "b": 2}, map[string]float64{"a": 1, "b": 5, "c": 1}); len(d) != 2 || d["b"] != 3 || d["c"] != 1 {
+		t.Errorf("DiffNumbers() = %v", d)
+	}
+}
@@ -0,0 +1,50 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"math"
+)
+
+// Stats raw stats object of one game, e.g. player.stats.Bedwars
+type Stats map[string]any
+
+// GameStats decode the stats object of game ("Bedwars", "SkyWars", "Duels"...)
+// Returns an empty Stats when the player never played it
+func (p *Player) GameStats(game string) Stats {
+	s := Stats{}
+	if raw, ok := p.Stats[game]; ok {
+		_ = json.Unmarshal(raw, &s)
+	}
+	return s
+}
+
+// Float numeric value of key, 0 if missing or not a number
+func (s Stats) Float(key string) float64 {
+	v, _ := s[key].(float64)
+	return v
+}
+
+// Int numeric value of key truncated to int64, 0 if missing or not a number
+func (s Stats) Int(key string) int64 {
+	return int64(s.Float(key))
+}
+
+// String string value of key, empty if missing or not a string
+func (s Stats) String(key string) string {
+	v, _ := s[key].(string)
+	return v
+}
+
+// Object nested object of key, nil if missing
+func (s Stats) Object(key string) Stats {
+	v, _ := s[key].(map[string]any)
+	return v
+}
+
+// Ratio a/b the way stat sites show it, a when b is 0, rounded to 2 decimals
+func Ratio[T int64 | float64](a, b T) float64 {
+	if b == 0 {
+		return math.Round(float64(a)*100) / 100
+	}
+	return math.Round(float64(a)/float64(b)*100) / 100
+}
@@ -0,0 +1,128 @@
+package hypixel
+
+import "strings"
+
+// Status typed GetStatus session
+type Status struct {
+	Online   bool   `json:"online"`
+	GameType string `json:"gameType"`
+	Mode     string `json:"mode"`
+	Map      string `json:"map"`
+}
+
+// ParseStatus decode a GetStatus response
+func ParseStatus(resp Response) (Status, error) {
+	var body struct {
+		Session Status `json:"session"`
+	}
+	if err := decodeResponse(resp, &body); err != nil {
+		return Status{}, err
+	}
+	return body.Session, nil
+}
+
+// LocationKind where an online player is
+type LocationKind uint8
+
+const (
+	LocationOffline LocationKind = iota
+	LocationLobby
+	LocationGame
+	LocationSkyBlock
+)
+
+// String impl fmt.Stringer
+func (k LocationKind) String() string {
+	switch k {
+	case LocationOffline:
+		return "offline"
+	case LocationLobby:
+		return "lobby"
+	case LocationGame:
+		return "game"
+	case LocationSkyBlock:
+		return "skyblock"
+	}
+	return "unknown"
+}
+
+// Location human readable location of a Status
+type Location struct {
+	Kind     LocationKind
+	GameName string // "Bed Wars"
+	ModeName string // "Solo", the island name on SkyBlock, empty in lobbies
+	Map      string
+}
+
+// String e.g. "Bed Wars Lobby", "Bed Wars: Solo (Lighthouse)", "SkyBlock: Private Island"
+func (l Location) String() string {
+	switch l.Kind {
+	case LocationOffline:
+		return "Offline"
+	case LocationLobby:
+		return l.GameName + " Lobby"
+	case LocationSkyBlock:
+		return l.GameName + ": " + l.ModeName
+	case LocationGame:
+		s := l.GameName
+		if l.ModeName != "" {
+			s += ": " + l.ModeName
+		}
+		if l.Map != "" {
+			s += " (" + l.Map + ")"
+		}
+		return s
+	}
+	return ""
+}
+
+// Location resolve gameType and mode into display names using info, info may be nil
+func (s Status) Location(info Games) Location {
+	if !s.Online {
+		return Location{Kind: LocationOffline}
+	}
+	l := Location{GameName: info.Name(s.GameType), Map: s.Map}
+	switch {
+	case strings.EqualFold(s.Mode, "LOBBY"):
+		l.Kind = LocationLobby
+	case strings.EqualFold(s.GameType, "SKYBLOCK"):
+		l.Kind = LocationSkyBlock
+		l.ModeName = SkyBlockIslandName(s.Mode)
+	default:
+		l.Kind = LocationGame
+		l.ModeName = info.ModeName(s.GameType, s.Mode)
+	}
+	return l
+}
+
+// skyBlockIslands SkyBlock status mode to island name
+var skyBlockIslands = map[string]string{
+	"dynamic":         "Private Island",
+	"hub":             "Hub",
+	"garden":          "The Garden",
+	"farming_1":       "The Farming Islands",
+	"foraging_1":      "The Park",
+	"fishing_1":       "Backwater Bayou",
+	"mining_1":        "Gold Mine",
+	"mining_2":        "Deep Caverns",
+	"mining_3":        "Dwarven Mines",
+	"crystal_hollows": "Crystal Hollows",
+	"mineshaft":       "Glacite Mineshafts",
+	"combat_1":        "Spider's Den",
+	"combat_3":        "The End",
+	"crimson_isle":    "Crimson Isle",
+	"kuudra":          "Kuudra",
+	"dungeon_hub":     "Dungeon Hub",
+	"dungeon":         "Dungeons",
+	"winter":          "Jerry's Workshop",
+	"dark_auction":    "Dark Auction",
+	"rift":            "The Rift",
+}
+
+// SkyBlockIslandName friendly name of a SkyBlock status mode, the mode itself when unknown
+func SkyBlockIslandName(mode string) string {
+	if name, ok := skyBlockIslands[strings.ToLower(mode)]; ok {
+		return name
+	}
+	return mode
+}
@@ -0,0 +1,36 @@
+package hypixel
+
+import "testing"
+
+func TestParseStatus(t *testing.T) {
+	s, err := ParseStatus(Response{Content: []byte(`{"success":true,"uuid":"u","session":{"online":true,"gameType":"BEDWARS","mode":"EIGHT_ONE","map":"Lighthouse"}}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !s.Online || s.GameType != "BEDWARS" || s.Map != "Lighthouse" {
+		t.Errorf("got %+v", s)
+	}
+}
+
+func TestStatus_Location(t *testing.T) {
+	info, _ := ParseGames(Response{Content: []byte(testGames)})
+	info["SKYBLOCK"] = GameInfo{Name: "SkyBlock"}
+	tests := []struct {
+		status Status
+		kind   LocationKind
+		want   string
+	}{
+		{Status{}, LocationOffline, "Offline"},
+		{Status{Online: true, GameType: "BEDWARS", Mode: "LOBBY"}, LocationLobby, "Bed Wars Lobby"},
+		{Status{Online: true, GameType: "BEDWARS", Mode: "EIGHT_ONE", Map: "Lighthouse"}, LocationGame, "Bed Wars: Solo (Lighthouse)"},
+		{Status{Online: true, GameType: "SKYBLOCK", Mode: "dynamic"}, LocationSkyBlock, "SkyBlock: Private Island"},
+		{Status{Online: true, GameType: "SKYBLOCK", Mode: "new_island"}, LocationSkyBlock, "SkyBlock: new_island"},
+		{Status{Online: true, GameType: "PIT", Mode: "PIT"}, LocationGame, "PIT: PIT"},
+	}
+	for _, tt := range tests {
+		got := tt.status.Location(info)
+		if got.Kind != tt.kind || got.String() != tt.want {
+			t.Errorf("Location(%+v) = %s %q; want %s %q", tt.status, got.Kind, got, tt.kind, tt.want)
+		}
+	}
+}
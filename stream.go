@@ -0,0 +1,185 @@
+package hypixel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrBodyTooLarge response body exceeded Client.SetMaxBodySize
+var ErrBodyTooLarge = errors.New("hypixel: response body too large")
+
+// Stream like Get, but fn reads the body as it arrives instead of it being buffered in Content
+// PreRequestHook and Callback are skipped, they work on Content
+//
+// Use StreamArray or StreamObject inside fn to decode large payloads element by element.
+func (c *Client) Stream(r Request, fn func(resp Response, body io.Reader) error) (Response, error) {
+	if fn == nil {
+		return Response{}, errors.New("hypixel: nil stream func")
+	}
+	return c.do(r, fn)
+}
+
+// StreamArray decode the JSON array at path element by element, path is dot separated ("auctions", "items")
+// Every other value met on the way is returned by its dotted path, so "success", "cause", "page"... stay available.
+// A missing or null array is not an error, check the returned fields instead.
+func StreamArray[T any](body io.Reader, path string, fn func(v T) error) (map[string]json.RawMessage, error) {
+	return streamJSON(body, path, func(dec *json.Decoder) error {
+		return decodeElements(dec, '[', ']', func() error {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				return err
+			}
+			return fn(v)
+		})
+	})
+}
+
+// StreamObject decode the JSON object at path entry by entry, e.g. "profile.members"
+// Fields are returned like StreamArray.
+func StreamObject[T any](body io.Reader, path string, fn func(key string, v T) error) (map[string]json.RawMessage, error) {
+	return streamJSON(body, path, func(dec *json.Decoder) error {
+		return decodeElements(dec, '{', '}', func() error {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, ok := tok.(string)
+			if !ok {
+				return fmt.Errorf("hypixel: unexpected token %v, want object key", tok)
+			}
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				return err
+			}
+			return fn(key, v)
+		})
+	})
+}
+
+func streamJSON(body io.Reader, path string, target func(dec *json.Decoder) error) (map[string]json.RawMessage, error) {
+	dec := json.NewDecoder(body)
+	fields := map[string]json.RawMessage{}
+	tok, err := dec.Token()
+	if err != nil {
+		return fields, err
+	}
+	if tok != json.Delim('{') {
+		return fields, fmt.Errorf("hypixel: unexpected token %v, want object", tok)
+	}
+	return fields, walkJSON(dec, strings.Split(path, "."), "", fields, target)
+}
+
+// walkJSON walk an object whose '{' was already read, following path down to target
+func walkJSON(dec *json.Decoder, path []string, prefix string, fields map[string]json.RawMessage, target func(dec *json.Decoder) error) error {
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("hypixel: unexpected token %v, want object key", tok)
+		}
+		full := prefix + key
+		switch {
+		case key == path[0] && len(path) == 1:
+			if err := target(dec); err != nil {
+				return err
+			}
+		case key == path[0]:
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			if tok == nil {
+				continue
+			}
+			if tok != json.Delim('{') {
+				return fmt.Errorf("hypixel: %s: unexpected token %v, want object", full, tok)
+			}
+			if err := walkJSON(dec, path[1:], full+".", fields, target); err != nil {
+				return err
+			}
+		default:
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
+			}
+			fields[full] = raw
+		}
+	}
+	_, err := dec.Token() // '}'
+	return err
+}
+
+// decodeElements read open, call next while elements remain, then read end
+// A null value is treated as empty
+func decodeElements(dec *json.Decoder, open, end json.Delim, next func() error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != open {
+		return fmt.Errorf("hypixel: unexpected token %v, want %v", tok, open)
+	}
+	for dec.More() {
+		if err := next(); err != nil {
+			return err
+		}
+	}
+	tok, err = dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != end {
+		return fmt.Errorf("hypixel: unexpected token %v, want %v", tok, end)
+	}
+	return nil
+}
+
+// countReader count bytes read through it
+type countReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// limitBody fail with ErrBodyTooLarge once more than limit bytes are read, limit <= 0 means unlimited
+func limitBody(r io.Reader, limit int64) io.Reader {
+	if limit <= 0 {
+		return r
+	}
+	return &limitReader{r: r, left: limit}
+}
+
+type limitReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if l.left < 0 {
+		return 0, ErrBodyTooLarge
+	}
+	// read one byte past the limit to tell an exact fit from an overflow
+	if l.left < int64(len(p)) {
+		p = p[:l.left+1]
+	}
+	n, err := l.r.Read(p)
+	l.left -= int64(n)
+	if l.left < 0 {
+		return n + int(l.left), ErrBodyTooLarge
+	}
+	return n, err
+}
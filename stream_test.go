@@ -0,0 +1,130 @@
+package hypixel
+
+import (
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStreamArray(t *testing.T) {
+	body := `{"success":true,"page":0,"totalPages":3,"auctions":[{"uuid":"a"},{"uuid":"b"}],"lastUpdated":1}`
+	var got []string
+	fields, err := StreamArray(strings.NewReader(body), "auctions", func(v struct{ UUID string }) error {
+		got = append(got, v.UUID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(got, ",") != "a,b" {
+		t.Errorf("got %v; want [a b]", got)
+	}
+	if string(fields["totalPages"]) != "3" || string(fields["success"]) != "true" || string(fields["lastUpdated"]) != "1" {
+		t.Errorf("fields = %v", fields)
+	}
+}
+
+func TestStreamObject(t *testing.T) {
+	body := `{"success":true,"profile":{"profile_id":"p","members":{"u1":{"coin_purse":1},"u2":{"coin_purse":2}},"cute_name":"Apple"}}`
+	sum := 0.0
+	keys := ""
+	fields, err := StreamObject(strings.NewReader(body), "profile.members", func(k string, v map[string]float64) error {
+		keys += k
+		sum += v["coin_purse"]
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys != "u1u2" || sum != 3 {
+		t.Errorf("keys=%q sum=%v", keys, sum)
+	}
+	if string(fields["profile.cute_name"]) != `"Apple"` {
+		t.Errorf("fields = %v", fields)
+	}
+}
+
+func TestStreamArray_Missing(t *testing.T) {
+	fields, err := StreamArray(strings.NewReader(`{"success":false,"cause":"Invalid API key"}`), "auctions", func(any) error {
+		t.Error("unexpected element")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(fields["cause"]) != `"Invalid API key"` {
+		t.Errorf("fields = %v", fields)
+	}
+}
+
+func TestStreamArray_StopEarly(t *testing.T) {
+	stop := errors.New("stop")
+	n := 0
+	_, err := StreamArray(strings.NewReader(`{"items":[1,2,3]}`), "items", func(int) error {
+		n++
+		return stop
+	})
+	if !errors.Is(err, stop) || n != 1 {
+		t.Errorf("err=%v n=%d; want stop after 1", err, n)
+	}
+}
+
+func TestLimitBody(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int64
+		want  string
+		err   error
+	}{
+		{"unlimited", 0, "12345", nil},
+		{"one byte", 1, "1", ErrBodyTooLarge},
+		{"exact fit", 5, "12345", nil},
+		{"overflow", 4, "1234", ErrBodyTooLarge},
+		{"max int64", math.MaxInt64, "12345", nil},
+	}
+	for _, tt := range tests {
+		b, err := io.ReadAll(limitBody(strings.NewReader("12345"), tt.limit))
+		if string(b) != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("%s: got %q, %v; want %q, %v", tt.name, b, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestClient_Stream(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"success":true,"items":[{"id":"A"},{"id":"B"},{"id":"C"}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", nil)
+	c.SetBaseURL(srv.URL)
+	c.SetPreRequestHook(func(Request) (Response, error) {
+		t.Error("hook must be skipped when streaming")
+		return Response{}, nil
+	})
+
+	n := 0
+	resp, err := c.Stream(Request{Path: "resources/skyblock/items"}, func(_ Response, body io.Reader) error {
+		_, err := StreamArray(body, "items", func(struct{ ID string }) error {
+			n++
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 3 || resp.Status != 200 || resp.Content != nil || resp.Meta.BodySize == 0 {
+		t.Errorf("n=%d status=%d meta=%+v", n, resp.Status, resp.Meta)
+	}
+
+	c.SetPreRequestHook(nil)
+	c.SetMaxBodySize(10)
+	if _, err := c.Get(Request{Path: "resources/skyblock/items"}); !errors.Is(err, ErrBodyTooLarge) {
+		t.Errorf("Get err = %v; want ErrBodyTooLarge", err)
+	}
+}
@@ -0,0 +1,88 @@
+package hypixel
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Tracer starts a span for every Client.Get call
+// It mirrors the small part of OpenTelemetry's trace.Tracer that the client needs,
+// so an otel adapter is a few lines and the core package stays dependency free:
+//
+//	type otelTracer struct{ t trace.Tracer }
+//
+//	func (o otelTracer) Start(ctx context.Context, name string) (context.Context, hypixel.Span) {
+//		ctx, s := o.t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{s}
+//	}
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span one traced Get call
+// Attribute values use slog.Value kinds (String, Int64, Bool, Duration...)
+type Span interface {
+	SetAttributes(attrs ...slog.Attr)
+	AddEvent(name string, attrs ...slog.Attr)
+	RecordError(err error)
+	End()
+}
+
+// span attribute keys
+const (
+	TraceAttrPath          = "hypixel.path"
+	TraceAttrMethod        = "http.request.method"
+	TraceAttrStatus        = "http.response.status_code"
+	TraceAttrRateRemaining = "hypixel.rate_limit.remaining"
+	TraceAttrCache         = "hypixel.cache"
+	TraceAttrRetries       = "hypixel.retry_count"
+
+	// TraceEventRateWait event added after RateLimit.WaitIfNeeded returns, with a "duration" attribute
+	TraceEventRateWait = "hypixel.rate_limit.wait"
+)
+
+// startSpan start a span for r, returns a nil span when no Tracer is set
+func (c *Client) startSpan(ctx context.Context, r Request) (context.Context, Span) {
+	t := c.GetTracer()
+	if t == nil {
+		return ctx, nil
+	}
+	return t.Start(ctx, "hypixel "+r.Path)
+}
+
+// endSpan record the final attributes of a Get call and end the span
+func (c *Client) endSpan(span Span, r Request, resp Response, err error) {
+	if span == nil {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String(TraceAttrPath, r.Path),
+		slog.String(TraceAttrMethod, r.Method),
+		slog.Int(TraceAttrStatus, resp.Status),
+		slog.String(TraceAttrCache, resp.Meta.cacheStatus()),
+		slog.Int(TraceAttrRetries, resp.Meta.Retries()),
+	}
+	if resp.Meta.RateRemaining >= 0 {
+		attrs = append(attrs, slog.Int(TraceAttrRateRemaining, int(resp.Meta.RateRemaining)))
+	}
+	span.SetAttributes(attrs...)
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+// waitRate block on the RateLimit and report the blocked time to span
+func (c *Client) waitRate(span Span) time.Duration {
+	if c.GetRate() == nil {
+		return 0
+	}
+	start := time.Now()
+	c.GetRate().WaitIfNeeded()
+	waited := time.Since(start)
+	if span != nil {
+		span.AddEvent(TraceEventRateWait, slog.Duration("duration", waited))
+	}
+	return waited
+}
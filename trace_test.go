@@ -0,0 +1,81 @@
+package hypixel
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type recordSpan struct {
+	name   string
+	attrs  map[string]slog.Value
+	events []string
+	err    error
+	ended  bool
+}
+
+func (s *recordSpan) SetAttributes(attrs ...slog.Attr) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordSpan) AddEvent(name string, _ ...slog.Attr) {
+	s.events = append(s.events, name)
+}
+
+func (s *recordSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *recordSpan) End() {
+	s.ended = true
+}
+
+type recordTracer struct {
+	spans []*recordSpan
+}
+
+func (t *recordTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &recordSpan{name: name, attrs: map[string]slog.Value{}}
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+func TestClient_Tracer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "9")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	tr := &recordTracer{}
+	c := NewClient("key", NewRateLimit())
+	c.SetBaseURL(srv.URL)
+	c.SetTracer(tr)
+
+	if _, err := c.Get(Request{Path: "guild"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tr.spans) != 1 {
+		t.Fatalf("got %d spans; want 1", len(tr.spans))
+	}
+	s := tr.spans[0]
+	if !s.ended || s.name != "hypixel guild" {
+		t.Errorf("span name=%q ended=%v", s.name, s.ended)
+	}
+	if got := s.attrs[TraceAttrStatus].Int64(); got != 404 {
+		t.Errorf("status attr = %d; want 404", got)
+	}
+	if got := s.attrs[TraceAttrRateRemaining].Int64(); got != 9 {
+		t.Errorf("rate remaining attr = %d; want 9", got)
+	}
+	if got := s.attrs[TraceAttrCache].String(); got != "miss" {
+		t.Errorf("cache attr = %q; want miss", got)
+	}
+	if len(s.events) != 1 || s.events[0] != TraceEventRateWait {
+		t.Errorf("events = %v; want [%s]", s.events, TraceEventRateWait)
+	}
+}
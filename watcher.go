@@ -0,0 +1,272 @@
+package hypixel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// StatusEventKind what changed between two polls
+type StatusEventKind uint8
+
+const (
+	StatusWentOnline StatusEventKind = iota
+	StatusWentOffline
+	StatusChangedGame // gameType changed
+	StatusChangedMode // same game, mode changed
+	StatusChangedMap  // same game and mode, map changed
+	StatusPollError   // GetStatus failed, see StatusEvent.Err
+)
+
+// String impl fmt.Stringer
+func (k StatusEventKind) String() string {
+	switch k {
+	case StatusWentOnline:
+		return "online"
+	case StatusWentOffline:
+		return "offline"
+	case StatusChangedGame:
+		return "game"
+	case StatusChangedMode:
+		return "mode"
+	case StatusChangedMap:
+		return "map"
+	case StatusPollError:
+		return "error"
+	}
+	return "unknown"
+}
+
+// StatusEvent emitted by Watcher
+type StatusEvent struct {
+	UUID     string
+	Kind     StatusEventKind
+	Previous Status
+	Current  Status
+	Time     time.Time
+	Err      error
+}
+
+// Watcher polls GetStatus for a set of players and emits a StatusEvent on every change
+// The first poll of a player only records its status.
+type Watcher struct {
+	client   *Client
+	interval time.Duration
+	mu       sync.Mutex // protects everything below
+	budget   int
+	reserve  int32
+	order    []string
+	last     map[string]*Status // nil until first polled
+	next     int                // round robin position in order
+	events   chan StatusEvent
+}
+
+// NewWatcher create a watcher polling every interval
+func NewWatcher(client *Client, interval time.Duration) *Watcher {
+	return &Watcher{
+		client:   client,
+		interval: interval,
+		last:     map[string]*Status{},
+		events:   make(chan StatusEvent, 64),
+	}
+}
+
+// SetBudget poll at most n players per interval, the rest wait for the next rounds. 0 means all
+func (w *Watcher) SetBudget(n int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.budget = n
+}
+
+// SetReserve stop a round early once the client's RateLimit remaining drops to n,
+// keeping quota for other requests
+func (w *Watcher) SetReserve(n int32) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.reserve = n
+}
+
+// Add start watching players, already watched ones are ignored
+func (w *Watcher) Add(uuids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, u := range uuids {
+		if _, ok := w.last[u]; ok {
+			continue
+		}
+		w.last[u] = nil
+		w.order = append(w.order, u)
+	}
+}
+
+// Remove stop watching players
+func (w *Watcher) Remove(uuids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, u := range uuids {
+		if _, ok := w.last[u]; !ok {
+			continue
+		}
+		delete(w.last, u)
+		i := slices.Index(w.order, u)
+		w.order = slices.Delete(w.order, i, i+1)
+		if i < w.next {
+			w.next--
+		}
+	}
+	// removed the cursor's player from the end, or emptied the set
+	if w.next >= len(w.order) {
+		w.next = 0
+	}
+}
+
+// UUIDs watched players
+func (w *Watcher) UUIDs() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return slices.Clone(w.order)
+}
+
+// Events changes, closed when Run returns
+func (w *Watcher) Events() <-chan StatusEvent {
+	return w.events
+}
+
+// Run poll until ctx is done, then close Events
+// Must be called once, fails if the interval is not positive
+func (w *Watcher) Run(ctx context.Context) error {
+	defer close(w.events)
+	if w.interval <= 0 {
+		return errors.New("hypixel: watcher interval must be positive")
+	}
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.poll(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// round players to poll this interval, starting at the round robin position
+// The position only moves in advance, so players skipped by the reserve come first next round
+func (w *Watcher) round() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	n := len(w.order)
+	if w.budget > 0 && w.budget < n {
+		n = w.budget
+	}
+	out := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		out = append(out, w.order[(w.next+i)%len(w.order)])
+	}
+	return out
+}
+
+// advance move the round robin position past uuid once it was polled
+func (w *Watcher) advance(uuid string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.next < len(w.order) && w.order[w.next] == uuid {
+		w.next = (w.next + 1) % len(w.order)
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context) error {
+	for _, uuid := range w.round() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if rate := w.client.GetRate(); rate != nil {
+			w.mu.Lock()
+			reserve := w.reserve
+			w.mu.Unlock()
+			if rem := rate.GetRemaining(); rem >= 0 && rem <= reserve {
+				return nil
+			}
+		}
+
+		status, err := w.fetch(ctx, uuid)
+		now := time.Now()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			w.advance(uuid)
+			if err := w.emit(ctx, StatusEvent{UUID: uuid, Kind: StatusPollError, Time: now, Err: err}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		w.advance(uuid)
+		w.mu.Lock()
+		prev, watched := w.last[uuid]
+		if watched {
+			w.last[uuid] = &status
+		}
+		w.mu.Unlock()
+		if !watched || prev == nil {
+			continue
+		}
+		if kind, changed := statusChange(*prev, status); changed {
+			if err := w.emit(ctx, StatusEvent{UUID: uuid, Kind: kind, Previous: *prev, Current: status, Time: now}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (w *Watcher) fetch(ctx context.Context, uuid string) (Status, error) {
+	resp, err := w.client.Get(Request{
+		Context: ctx,
+		Method:  http.MethodGet,
+		Header:  w.client.AuthHeader(),
+		Path:    "status",
+		Params: Params{
+			"uuid": uuid,
+		},
+	})
+	if err != nil {
+		return Status{}, err
+	}
+	return ParseStatus(resp)
+}
+
+func (w *Watcher) emit(ctx context.Context, e StatusEvent) error {
+	select {
+	case w.events <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// statusChange the most significant difference between two statuses
+func statusChange(prev, cur Status) (StatusEventKind, bool) {
+	switch {
+	case !prev.Online && cur.Online:
+		return StatusWentOnline, true
+	case prev.Online && !cur.Online:
+		return StatusWentOffline, true
+	case !cur.Online:
+		return 0, false
+	case prev.GameType != cur.GameType:
+		return StatusChangedGame, true
+	case prev.Mode != cur.Mode:
+		return StatusChangedMode, true
+	case prev.Map != cur.Map:
+		return StatusChangedMap, true
+	}
+	return 0, false
+}
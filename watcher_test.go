@@ -0,0 +1,152 @@
+package hypixel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatcher(t *testing.T) {
+	sessions := []string{
+		`{"online":false}`,
+		`{"online":true,"gameType":"BEDWARS","mode":"LOBBY"}`,
+		`{"online":true,"gameType":"BEDWARS","mode":"EIGHT_ONE","map":"Lighthouse"}`,
+		`{"online":true,"gameType":"DUELS","mode":"DUELS_SUMO_DUEL"}`,
+		`{"online":false}`,
+	}
+	var mu sync.Mutex
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		s := sessions[min(calls, len(sessions)-1)]
+		calls++
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"success":true,"session":` + s + `}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", nil)
+	c.SetBaseURL(srv.URL)
+	w := NewWatcher(c, 5*time.Millisecond)
+	w.Add("a")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go func() { _ = w.Run(ctx) }()
+
+	want := []StatusEventKind{StatusWentOnline, StatusChangedMode, StatusChangedGame, StatusWentOffline}
+	for i, kind := range want {
+		e := <-w.Events()
+		if e.Kind != kind || e.UUID != "a" {
+			t.Fatalf("event %d = %s %s; want %s", i, e.UUID, e.Kind, kind)
+		}
+	}
+	cancel()
+	for range w.Events() {
+	}
+}
+
+func TestWatcher_Budget(t *testing.T) {
+	w := NewWatcher(NewClient("key", nil), time.Second)
+	w.Add("a", "b", "c", "a")
+	w.SetBudget(2)
+	if got := w.round(); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("round 1 = %v", got)
+	}
+	w.advance("a")
+	w.advance("b")
+	if got := w.round(); got[0] != "c" || got[1] != "a" {
+		t.Errorf("round 2 = %v", got)
+	}
+	w.advance("c")
+	w.Remove("a")
+	if got := w.UUIDs(); len(got) != 2 {
+		t.Errorf("UUIDs() = %v", got)
+	}
+	if got := w.round(); len(got) != 2 || got[0] != "b" {
+		t.Errorf("round after remove = %v", got)
+	}
+}
+
+func TestWatcher_Reserve(t *testing.T) {
+	var mu sync.Mutex
+	var polled []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		polled = append(polled, r.URL.Query().Get("uuid"))
+		mu.Unlock()
+		w.Header().Set("RateLimit-Remaining", "0")
+		_, _ = w.Write([]byte(`{"success":true,"session":{"online":false}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", NewRateLimit())
+	c.SetBaseURL(srv.URL)
+	w := NewWatcher(c, time.Second)
+	w.Add("a", "b", "c", "d")
+	// every round stops after one player, the next round must carry on from there
+	for range 4 {
+		c.GetRate().Reset()
+		if err := w.poll(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(polled) != 4 || polled[0] != "a" || polled[1] != "b" || polled[2] != "c" || polled[3] != "d" {
+		t.Errorf("polled = %v; want a b c d", polled)
+	}
+}
+
+func TestWatcher_Interval(t *testing.T) {
+	w := NewWatcher(NewClient("key", nil), 0)
+	if err := w.Run(context.Background()); err == nil {
+		t.Error("expected error for zero interval")
+	}
+	if _, ok := <-w.Events(); ok {
+		t.Error("Events should be closed")
+	}
+}
+
+func TestWatcher_RemoveAtCursor(t *testing.T) {
+	w := NewWatcher(NewClient("key", nil), time.Second)
+	w.Add("a", "b", "c")
+	w.SetBudget(2)
+	w.round()
+	w.advance("a")
+	w.advance("b")
+	// the cursor is on c, the last slot
+	w.Remove("c")
+	got := w.round()
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("round after removing cursor = %v", got)
+	}
+	w.advance("a")
+	if got := w.round(); got[0] != "b" {
+		t.Errorf("round after advance = %v", got)
+	}
+}
+
+func TestWatcher_RemoveAll(t *testing.T) {
+	w := NewWatcher(NewClient("key", nil), time.Second)
+	w.Add("a", "b", "c")
+	w.round()
+	w.advance("a")
+	w.advance("b")
+	w.Remove("c", "a", "b")
+	if got := w.round(); len(got) != 0 {
+		t.Errorf("round of empty watcher = %v", got)
+	}
+	w.Add("c", "d")
+	got := w.round()
+	if len(got) != 2 || got[0] != "c" || got[1] != "d" {
+		t.Errorf("round after re-add = %v", got)
+	}
+	w.advance("c")
+	if got := w.round(); got[0] != "d" {
+		t.Errorf("round after advance = %v", got)
+	}
+}